@@ -2,6 +2,7 @@ package tapd
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 )
 
@@ -287,6 +288,7 @@ type (
 		CustomPlanField10 string        `json:"custom_plan_field_10,omitempty"`
 		PriorityLabel     PriorityLabel `json:"priority_label,omitempty"`
 		WorkspaceID       string        `json:"workspace_id,omitempty"`
+		CustomFields      CustomFields  `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
 	}
 
 	GetBugFieldsLabelRequest struct {
@@ -342,6 +344,7 @@ type (
 		BugType       *string        `json:"bugtype,omitempty"`        // 缺陷类型
 		Label         *string        `json:"label,omitempty"`          // 标签，多个以英文竖线分隔
 		Deadline      *string        `json:"deadline,omitempty"`       // 解决期限
+		CustomFields  CustomFields   `json:"-"`                        // 自定义字段，按字段标识（如 custom_field_17）设置，与同名字段同时设置时以同名字段为准
 	}
 
 	CopyBugRequest struct {
@@ -441,6 +444,7 @@ type (
 	GetBugsByViewConfIDRequest struct {
 		ViewConfID  *int64  `url:"view_conf_id,omitempty"` // [必须]视图ID
 		CurrentUser *string `url:"current_user,omitempty"` // 当前登录用户视图
+		QueryToken  *string `url:"query_token,omitempty"`  // 列表queryToken，限定返回的缺陷范围
 		GetBugsRequest
 	}
 
@@ -481,12 +485,12 @@ type (
 	}
 
 	GetBugsRequest struct {
-		ID                *Multi[int64]      `url:"id,omitempty"`               // ID 支持多ID查询
+		ID                Filter             `url:"id,omitempty"`               // ID 支持多ID查询
 		Title             *string            `url:"title,omitempty"`            // 标题 支持模糊匹配
 		Priority          *string            `url:"priority,omitempty"`         // 优先级。为了兼容自定义优先级，请使用 priority_label 字段，详情参考：如何兼容自定义优先级
 		PriorityLabel     *PriorityLabel     `url:"priority_label,omitempty"`   // 优先级。推荐使用这个字段
 		Severity          *Enum[BugSeverity] `url:"severity,omitempty"`         // 严重程度 支持枚举查询
-		Status            *Enum[string]      `url:"status,omitempty"`           // 状态 支持不等于查询、枚举查询
+		Status            Filter             `url:"status,omitempty"`           // 状态 支持不等于查询、枚举查询
 		VStatus           *string            `url:"v_status,omitempty"`         // 状态(支持传入中文状态名称)
 		Label             *Enum[string]      `url:"label,omitempty"`            // 标签查询 支持枚举查询
 		IterationID       *Enum[string]      `url:"iteration_id,omitempty"`     // 迭代 支持枚举查询
@@ -512,15 +516,15 @@ type (
 		Fixer             *string            `url:"fixer,omitempty"`            // 修复人
 		Closer            *string            `url:"closer,omitempty"`           // 关闭人
 		LastModify        *string            `url:"lastmodify,omitempty"`       // 最后修改人
-		Created           *string            `url:"created,omitempty"`          // 创建时间 支持时间查询
-		InProgressTime    *string            `url:"in_progress_time,omitempty"` // 接受处理时间 支持时间查询
-		Resolved          *string            `url:"resolved,omitempty"`         // 解决时间 支持时间查询
-		VerifyTime        *string            `url:"verify_time,omitempty"`      // 验证时间 支持时间查询
-		Closed            *string            `url:"closed,omitempty"`           // 关闭时间 支持时间查询
-		RejectTime        *string            `url:"reject_time,omitempty"`      // 拒绝时间 支持时间查询
-		Modified          *string            `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
-		Begin             *string            `url:"begin,omitempty"`            // 预计开始
-		Due               *string            `url:"due,omitempty"`              // 预计结束
+		Created           Filter             `url:"created,omitempty"`          // 创建时间 支持时间查询
+		InProgressTime    Filter             `url:"in_progress_time,omitempty"` // 接受处理时间 支持时间查询
+		Resolved          Filter             `url:"resolved,omitempty"`         // 解决时间 支持时间查询
+		VerifyTime        Filter             `url:"verify_time,omitempty"`      // 验证时间 支持时间查询
+		Closed            Filter             `url:"closed,omitempty"`           // 关闭时间 支持时间查询
+		RejectTime        Filter             `url:"reject_time,omitempty"`      // 拒绝时间 支持时间查询
+		Modified          Filter             `url:"modified,omitempty"`         // 最后修改时间 支持时间查询
+		Begin             Filter             `url:"begin,omitempty"`            // 预计开始
+		Due               Filter             `url:"due,omitempty"`              // 预计结束
 		Deadline          *string            `url:"deadline,omitempty"`         // 解决期限
 		OS                *string            `url:"os,omitempty"`               // 操作系统
 		Platform          *string            `url:"platform,omitempty"`         // 软件平台
@@ -1140,6 +1144,7 @@ type (
 		CustomPlanField8  *string            `json:"custom_plan_field_8,omitempty"`
 		CustomPlanField9  *string            `json:"custom_plan_field_9,omitempty"`
 		CustomPlanField10 *string            `json:"custom_plan_field_10,omitempty"`
+		CustomFields      CustomFields       `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）设置，与同名字段同时设置时以同名字段为准
 	}
 
 	UpdateBugSystemSelectFieldOptionsRequest struct {
@@ -1807,3 +1812,33 @@ func (s *bugService) GetRemovedBugs(
 
 	return bugs, resp, nil
 }
+
+func (b *Bug) UnmarshalJSON(data []byte) error {
+	type alias Bug
+	if err := json.Unmarshal(data, (*alias)(b)); err != nil {
+		return err
+	}
+
+	fields, err := ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	b.CustomFields = fields
+
+	return nil
+}
+
+func (r CreateBugRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateBugRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
+
+func (r UpdateBugRequest) MarshalJSON() ([]byte, error) {
+	type alias UpdateBugRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
+
+// Definition converts the setting to a custom field definition.
+func (s *BugCustomFieldsSetting) Definition() *CustomFieldDefinition {
+	return newCustomFieldDefinition(s.CustomField, s.Name, s.Type, s.Options, s.Enabled)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -347,6 +348,8 @@ func TestBugService_GetBugs(t *testing.T) {
 
 		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
 		assert.Equal(t, PriorityLabelHigh.String(), r.URL.Query().Get("priority_label"))
+		assert.Equal(t, "-closed|rejected", r.URL.Query().Get("status"))
+		assert.Equal(t, "2024-01-01~2024-02-01", r.URL.Query().Get("created"))
 
 		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs.json"))
 	}))
@@ -354,6 +357,8 @@ func TestBugService_GetBugs(t *testing.T) {
 	bugs, _, err := client.BugService.GetBugs(ctx, &GetBugsRequest{
 		WorkspaceID:   new(11112222),
 		PriorityLabel: new(PriorityLabelHigh),
+		Status:        NotIn("closed", "rejected"),
+		Created:       Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)),
 	})
 	require.NoError(t, err)
 	require.True(t, len(bugs) > 0)
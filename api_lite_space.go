@@ -0,0 +1,175 @@
+package tapd
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	GetLiteSpaceInfoRequest struct {
+		WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+	}
+
+	CreateLiteSpaceRequest struct {
+		Name        *string        `json:"name,omitempty"`        // [必须]空间名称
+		CompanyID   *int           `json:"company_id,omitempty"`  // [必须]公司ID
+		Creator     *string        `json:"creator,omitempty"`     // [必须]创建人
+		Description *string        `json:"description,omitempty"` // 空间描述
+		Members     *Multi[string] `json:"members,omitempty"`     // 空间成员昵称，多个以英文逗号分隔
+	}
+
+	AddLiteSpaceMemberRequest struct {
+		WorkspaceID *int           `json:"workspace_id,omitempty"` // [必须]空间ID
+		Nicks       *Multi[string] `json:"nicks,omitempty"`        // [必须]用户英文昵称，多个以英文逗号分隔
+		Operator    *string        `json:"operator,omitempty"`     // 操作人
+	}
+
+	GetLiteSpaceMembersRequest struct {
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]空间ID
+		User        *Multi[string] `url:"user,omitempty"`         // 用户昵称或ID
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 返回的字段列表，以,分隔
+	}
+
+	GetUserLiteSpacesRequest struct {
+		Nick      *string `url:"nick,omitempty"`       // [必须]用户昵称
+		CompanyID *int    `url:"company_id,omitempty"` // [必须]公司ID
+	}
+)
+
+// LiteSpaceService 轻协作空间
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/
+type LiteSpaceService interface {
+	// GetLiteSpaceInfo 获取空间信息
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/get_space_info.html
+	GetLiteSpaceInfo(ctx context.Context, request *GetLiteSpaceInfoRequest, opts ...RequestOption) (*Workspace, *Response, error)
+
+	// CreateLiteSpace 新建空间
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/create_space.html
+	CreateLiteSpace(ctx context.Context, request *CreateLiteSpaceRequest, opts ...RequestOption) (*Workspace, *Response, error)
+
+	// AddLiteSpaceMember 添加空间成员
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/add_space_member.html
+	AddLiteSpaceMember(ctx context.Context, request *AddLiteSpaceMemberRequest, opts ...RequestOption) (bool, *Response, error)
+
+	// GetLiteSpaceMembers 获取空间成员列表
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/get_space_members.html
+	GetLiteSpaceMembers(ctx context.Context, request *GetLiteSpaceMembersRequest, opts ...RequestOption) ([]*User, *Response, error)
+
+	// GetUserLiteSpaces 获取用户所有参与的空间
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/space/get_user_spaces.html
+	GetUserLiteSpaces(ctx context.Context, request *GetUserLiteSpacesRequest, opts ...RequestOption) ([]*Workspace, *Response, error)
+}
+
+type liteSpaceService struct {
+	client *Client
+}
+
+var _ LiteSpaceService = (*liteSpaceService)(nil)
+
+func NewLiteSpaceService(client *Client) LiteSpaceService {
+	return &liteSpaceService{
+		client: client,
+	}
+}
+
+func (s *liteSpaceService) GetLiteSpaceInfo(
+	ctx context.Context, request *GetLiteSpaceInfoRequest, opts ...RequestOption,
+) (*Workspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workspaces/get_workspace_info", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Workspace *Workspace `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Workspace, resp, nil
+}
+
+func (s *liteSpaceService) CreateLiteSpace(
+	ctx context.Context, request *CreateLiteSpaceRequest, opts ...RequestOption,
+) (*Workspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workspaces/create", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Workspace *Workspace `json:"Workspace"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Workspace, resp, nil
+}
+
+func (s *liteSpaceService) AddLiteSpaceMember(
+	ctx context.Context, request *AddLiteSpaceMemberRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workspaces/add_member", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *liteSpaceService) GetLiteSpaceMembers(
+	ctx context.Context, request *GetLiteSpaceMembersRequest, opts ...RequestOption,
+) ([]*User, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workspaces/users", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		UserWorkspace *User `json:"UserWorkspace"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*User, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.UserWorkspace)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteSpaceService) GetUserLiteSpaces(
+	ctx context.Context, request *GetUserLiteSpacesRequest, opts ...RequestOption,
+) ([]*Workspace, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workspaces/user_participant_workspaces", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items workspaceItems
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return items, resp, nil
+}
@@ -0,0 +1,113 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiteSpaceService_GetLiteSpaceInfo(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workspaces/get_workspace_info", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_space/get_lite_space_info.json"))
+	}))
+
+	space, _, err := client.LiteSpaceService.GetLiteSpaceInfo(ctx, &GetLiteSpaceInfoRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "66667777", space.ID)
+	assert.Equal(t, "市场部协作空间", space.Name)
+	assert.Equal(t, "lite_project", space.Category)
+}
+
+func TestLiteSpaceService_CreateLiteSpace(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workspaces/create", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "新建协作空间", req["name"])
+		assert.Equal(t, float64(1000001), req["company_id"])
+		assert.Equal(t, "张三", req["creator"])
+		assert.Equal(t, "李四,王五", req["members"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_space/create_lite_space.json"))
+	}))
+
+	space, _, err := client.LiteSpaceService.CreateLiteSpace(ctx, &CreateLiteSpaceRequest{
+		Name:      new("新建协作空间"),
+		CompanyID: new(1000001),
+		Creator:   new("张三"),
+		Members:   NewMulti("李四", "王五"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "66668888", space.ID)
+	assert.Equal(t, "新建协作空间", space.Name)
+}
+
+func TestLiteSpaceService_AddLiteSpaceMember(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workspaces/add_member", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(66667777), req["workspace_id"])
+		assert.Equal(t, "李四,王五", req["nicks"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_space/add_lite_space_member.json"))
+	}))
+
+	ok, _, err := client.LiteSpaceService.AddLiteSpaceMember(ctx, &AddLiteSpaceMemberRequest{
+		WorkspaceID: new(66667777),
+		Nicks:       NewMulti("李四", "王五"),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestLiteSpaceService_GetLiteSpaceMembers(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workspaces/users", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_space/get_lite_space_members.json"))
+	}))
+
+	users, _, err := client.LiteSpaceService.GetLiteSpaceMembers(ctx, &GetLiteSpaceMembersRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "张三", users[0].User)
+	assert.Equal(t, []string{"1000000000000000002"}, users[0].RoleID)
+	assert.Nil(t, users[1].JoinProjectTime)
+}
+
+func TestLiteSpaceService_GetUserLiteSpaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workspaces/user_participant_workspaces", r.URL.Path)
+		assert.Equal(t, "张三", r.URL.Query().Get("nick"))
+		assert.Equal(t, "1000001", r.URL.Query().Get("company_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_space/get_user_lite_spaces.json"))
+	}))
+
+	spaces, _, err := client.LiteSpaceService.GetUserLiteSpaces(ctx, &GetUserLiteSpacesRequest{
+		Nick:      new("张三"),
+		CompanyID: new(1000001),
+	})
+	require.NoError(t, err)
+	require.Len(t, spaces, 2)
+	assert.Equal(t, "66668888", spaces[1].ID)
+}
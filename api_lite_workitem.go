@@ -0,0 +1,705 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type (
+	// LiteWorkItem 轻协作工作项
+	LiteWorkItem struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 空间ID
+		Name        string `json:"name,omitempty"`         // 标题
+		Description string `json:"description,omitempty"`  // 详细描述
+		Status      string `json:"status,omitempty"`       // 状态
+		Priority    string `json:"priority,omitempty"`     // 优先级
+		Owner       string `json:"owner,omitempty"`        // 处理人
+		CC          string `json:"cc,omitempty"`           // 抄送人
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Begin       string `json:"begin,omitempty"`        // 预计开始
+		Due         string `json:"due,omitempty"`          // 预计结束
+		Completed   string `json:"completed,omitempty"`    // 完成时间
+		GroupID     string `json:"group_id,omitempty"`     // 分组ID
+		ParentID    string `json:"parent_id,omitempty"`    // 父工作项ID
+		Label       string `json:"label,omitempty"`        // 标签
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+
+		CustomFields CustomFields `json:"-"` // 自定义字段
+	}
+
+	CreateLiteWorkItemRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `json:"name,omitempty"`         // [必须]标题
+		Description *string `json:"description,omitempty"`  // 详细描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		Priority    *string `json:"priority,omitempty"`     // 优先级
+		Owner       *string `json:"owner,omitempty"`        // 处理人
+		CC          *string `json:"cc,omitempty"`           // 抄送人
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+		Begin       *string `json:"begin,omitempty"`        // 预计开始
+		Due         *string `json:"due,omitempty"`          // 预计结束
+		GroupID     *int64  `json:"group_id,omitempty"`     // 分组ID
+		ParentID    *int64  `json:"parent_id,omitempty"`    // 父工作项ID
+		Label       *string `json:"label,omitempty"`        // 标签，多个以英文竖线分隔
+
+		CustomFields CustomFields `json:"-"` // 自定义字段
+	}
+
+	UpdateLiteWorkItemRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `json:"name,omitempty"`         // 标题
+		Description *string `json:"description,omitempty"`  // 详细描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		Priority    *string `json:"priority,omitempty"`     // 优先级
+		Owner       *string `json:"owner,omitempty"`        // 处理人
+		CC          *string `json:"cc,omitempty"`           // 抄送人
+		Begin       *string `json:"begin,omitempty"`        // 预计开始
+		Due         *string `json:"due,omitempty"`          // 预计结束
+		GroupID     *int64  `json:"group_id,omitempty"`     // 分组ID
+		ParentID    *int64  `json:"parent_id,omitempty"`    // 父工作项ID
+		Label       *string `json:"label,omitempty"`        // 标签，多个以英文竖线分隔
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+
+		CustomFields CustomFields `json:"-"` // 自定义字段
+	}
+
+	GetLiteWorkItemsRequest struct {
+		ID          Filter         `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string        `url:"name,omitempty"`         // 标题 支持模糊匹配
+		Status      Filter         `url:"status,omitempty"`       // 状态 支持枚举查询
+		Priority    *string        `url:"priority,omitempty"`     // 优先级
+		Owner       *string        `url:"owner,omitempty"`        // 处理人
+		Creator     *string        `url:"creator,omitempty"`      // 创建人
+		GroupID     *int64         `url:"group_id,omitempty"`     // 分组ID
+		ParentID    *int64         `url:"parent_id,omitempty"`    // 父工作项ID
+		Created     Filter         `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Modified    Filter         `url:"modified,omitempty"`     // 最后修改时间 支持时间查询
+		Due         Filter         `url:"due,omitempty"`          // 预计结束 支持时间查询
+		Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetLiteWorkItemsCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `url:"name,omitempty"`         // 标题 支持模糊匹配
+		Status      Filter  `url:"status,omitempty"`       // 状态 支持枚举查询
+		Priority    *string `url:"priority,omitempty"`     // 优先级
+		Owner       *string `url:"owner,omitempty"`        // 处理人
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		GroupID     *int64  `url:"group_id,omitempty"`     // 分组ID
+		ParentID    *int64  `url:"parent_id,omitempty"`    // 父工作项ID
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Modified    Filter  `url:"modified,omitempty"`     // 最后修改时间 支持时间查询
+		Due         Filter  `url:"due,omitempty"`          // 预计结束 支持时间查询
+	}
+
+	// LiteWorkItemGroup 轻协作工作项分组
+	LiteWorkItemGroup struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 空间ID
+		Name        string `json:"name,omitempty"`         // 分组名称
+		Sort        string `json:"sort,omitempty"`         // 排序
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	CreateLiteWorkItemGroupRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `json:"name,omitempty"`         // [必须]分组名称
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	UpdateLiteWorkItemGroupRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `json:"name,omitempty"`         // 分组名称
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+	}
+
+	GetLiteWorkItemGroupsRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `url:"name,omitempty"`         // 分组名称
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		Limit       *int    `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int    `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order  `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	}
+
+	GetLiteWorkItemGroupsCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		Name        *string `url:"name,omitempty"`         // 分组名称
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+	}
+
+	// LiteWorkItemChange 轻协作工作项动态
+	LiteWorkItemChange struct {
+		ID            string `json:"id,omitempty"`             // ID
+		WorkspaceID   string `json:"workspace_id,omitempty"`   // 空间ID
+		WorkitemID    string `json:"workitem_id,omitempty"`    // 工作项ID
+		Creator       string `json:"creator,omitempty"`        // 变更人
+		Created       string `json:"created,omitempty"`        // 变更时间
+		ChangeType    string `json:"change_type,omitempty"`    // 变更类型
+		ChangeSummary string `json:"change_summary,omitempty"` // 变更摘要
+		FieldChanges  []*struct {
+			Field       string `json:"field,omitempty"`        // 字段
+			FieldLabel  string `json:"field_label,omitempty"`  // 字段中文名
+			ValueBefore string `json:"value_before,omitempty"` // 变更前
+			ValueAfter  string `json:"value_after,omitempty"`  // 变更后
+		} `json:"field_changes,omitempty"` // 字段变更
+	}
+
+	GetLiteWorkItemChangesRequest struct {
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64  `url:"workitem_id,omitempty"`  // 工作项ID
+		Creator     *string `url:"creator,omitempty"`      // 变更人
+		Created     Filter  `url:"created,omitempty"`      // 变更时间 支持时间查询
+		Limit       *int    `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int    `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order  `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	}
+
+	GetLiteWorkItemChangesCountRequest struct {
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64  `url:"workitem_id,omitempty"`  // 工作项ID
+		Creator     *string `url:"creator,omitempty"`      // 变更人
+		Created     Filter  `url:"created,omitempty"`      // 变更时间 支持时间查询
+	}
+
+	GetLiteWorkItemCustomFieldsSettingsRequest struct {
+		WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+	}
+
+	GetLiteWorkItemFieldsLabelRequest struct {
+		WorkspaceID *int `url:"workspace_id,omitempty"` // [必须]空间ID
+	}
+
+	LiteWorkItemFieldLabel struct {
+		EN string `json:"en,omitempty"` // 字段英文名
+		CN string `json:"cn,omitempty"` // 字段中文标签
+	}
+
+	CreateLiteWorkItemRelationRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64        `json:"workitem_id,omitempty"`  // [必须]工作项ID
+		TargetType  *EntityType   `json:"target_type,omitempty"`  // [必须]关联的业务对象类型，story、bug
+		TargetIDs   *Multi[int64] `json:"target_ids,omitempty"`   // [必须]关联的业务对象ID
+	}
+
+	RemoveLiteWorkItemRelationRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64        `json:"workitem_id,omitempty"`  // [必须]工作项ID
+		TargetType  *EntityType   `json:"target_type,omitempty"`  // [必须]关联的业务对象类型，story、bug
+		TargetIDs   *Multi[int64] `json:"target_ids,omitempty"`   // [必须]解除关联的业务对象ID
+	}
+
+	GetLiteWorkItemRelatedStoriesRequest struct {
+		WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64 `url:"workitem_id,omitempty"`  // [必须]工作项ID
+	}
+
+	GetLiteWorkItemRelatedBugsRequest struct {
+		WorkspaceID *int   `url:"workspace_id,omitempty"` // [必须]空间ID
+		WorkitemID  *int64 `url:"workitem_id,omitempty"`  // [必须]工作项ID
+	}
+
+	GetRemovedLiteWorkItemsRequest struct {
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]空间ID
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		IsArchived  *int    `url:"is_archived,omitempty"`  // 是否查询归档的工作项，1 是
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Limit       *int    `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int    `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	}
+)
+
+// LiteWorkItemService 轻协作工作项
+//
+// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/
+type LiteWorkItemService interface {
+	// CreateLiteWorkItem 添加工作项
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/add_workitem.html
+	CreateLiteWorkItem(ctx context.Context, request *CreateLiteWorkItemRequest, opts ...RequestOption) (*LiteWorkItem, *Response, error)
+
+	// UpdateLiteWorkItem 更新工作项
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/update_workitem.html
+	UpdateLiteWorkItem(ctx context.Context, request *UpdateLiteWorkItemRequest, opts ...RequestOption) (*LiteWorkItem, *Response, error)
+
+	// GetLiteWorkItems 获取工作项
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitems.html
+	GetLiteWorkItems(ctx context.Context, request *GetLiteWorkItemsRequest, opts ...RequestOption) ([]*LiteWorkItem, *Response, error)
+
+	// GetLiteWorkItemsCount 获取工作项数量
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitems_count.html
+	GetLiteWorkItemsCount(ctx context.Context, request *GetLiteWorkItemsCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// CreateLiteWorkItemGroup 添加分组
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/add_workitem_group.html
+	CreateLiteWorkItemGroup(ctx context.Context, request *CreateLiteWorkItemGroupRequest, opts ...RequestOption) (*LiteWorkItemGroup, *Response, error)
+
+	// UpdateLiteWorkItemGroup 更新分组
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/update_workitem_group.html
+	UpdateLiteWorkItemGroup(ctx context.Context, request *UpdateLiteWorkItemGroupRequest, opts ...RequestOption) (*LiteWorkItemGroup, *Response, error)
+
+	// GetLiteWorkItemGroups 获取分组
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_groups.html
+	GetLiteWorkItemGroups(ctx context.Context, request *GetLiteWorkItemGroupsRequest, opts ...RequestOption) ([]*LiteWorkItemGroup, *Response, error)
+
+	// GetLiteWorkItemGroupsCount 获取分组数量
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_groups_count.html
+	GetLiteWorkItemGroupsCount(ctx context.Context, request *GetLiteWorkItemGroupsCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// GetLiteWorkItemChanges 获取工作项动态
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_changes.html
+	GetLiteWorkItemChanges(ctx context.Context, request *GetLiteWorkItemChangesRequest, opts ...RequestOption) ([]*LiteWorkItemChange, *Response, error)
+
+	// GetLiteWorkItemChangesCount 获取工作项动态数量
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_changes_count.html
+	GetLiteWorkItemChangesCount(ctx context.Context, request *GetLiteWorkItemChangesCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// GetLiteWorkItemCustomFieldsSettings 获取工作项自定义字段配置
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_custom_fields_settings.html
+	GetLiteWorkItemCustomFieldsSettings(ctx context.Context, request *GetLiteWorkItemCustomFieldsSettingsRequest, opts ...RequestOption) ([]*CustomFieldConfig, *Response, error)
+
+	// GetLiteWorkItemFieldsLabel 获取工作项所有字段的中英文
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_workitem_fields_label.html
+	GetLiteWorkItemFieldsLabel(ctx context.Context, request *GetLiteWorkItemFieldsLabelRequest, opts ...RequestOption) ([]*LiteWorkItemFieldLabel, *Response, error)
+
+	// CreateLiteWorkItemRelation 添加工作项与其他业务对象的关联关系
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/create_relation.html
+	CreateLiteWorkItemRelation(ctx context.Context, request *CreateLiteWorkItemRelationRequest, opts ...RequestOption) (bool, *Response, error)
+
+	// GetLiteWorkItemRelatedStories 获取关联需求
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_related_stories.html
+	GetLiteWorkItemRelatedStories(ctx context.Context, request *GetLiteWorkItemRelatedStoriesRequest, opts ...RequestOption) ([]*Story, *Response, error)
+
+	// GetLiteWorkItemRelatedBugs 获取关联缺陷
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_related_bugs.html
+	GetLiteWorkItemRelatedBugs(ctx context.Context, request *GetLiteWorkItemRelatedBugsRequest, opts ...RequestOption) ([]*Bug, *Response, error)
+
+	// RemoveLiteWorkItemRelation 解除工作项与其他业务对象的关联关系
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/remove_relation.html
+	RemoveLiteWorkItemRelation(ctx context.Context, request *RemoveLiteWorkItemRelationRequest, opts ...RequestOption) (bool, *Response, error)
+
+	// GetRemovedLiteWorkItems 获取回收站内的工作项
+	//
+	// https://open.tapd.cn/document/api-doc/%E8%BD%BB%E5%8D%8F%E4%BD%9CAPI%E6%96%87%E6%A1%A3/api_reference/workitem/get_removed_workitems.html
+	GetRemovedLiteWorkItems(ctx context.Context, request *GetRemovedLiteWorkItemsRequest, opts ...RequestOption) ([]*LiteWorkItem, *Response, error)
+}
+
+type liteWorkItemService struct {
+	client *Client
+}
+
+var _ LiteWorkItemService = (*liteWorkItemService)(nil)
+
+func NewLiteWorkItemService(client *Client) LiteWorkItemService {
+	return &liteWorkItemService{
+		client: client,
+	}
+}
+
+func (s *liteWorkItemService) CreateLiteWorkItem(
+	ctx context.Context, request *CreateLiteWorkItemRequest, opts ...RequestOption,
+) (*LiteWorkItem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Workitem *LiteWorkItem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Workitem, resp, nil
+}
+
+func (s *liteWorkItemService) UpdateLiteWorkItem(
+	ctx context.Context, request *UpdateLiteWorkItemRequest, opts ...RequestOption,
+) (*LiteWorkItem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Workitem *LiteWorkItem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Workitem, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItems(
+	ctx context.Context, request *GetLiteWorkItemsRequest, opts ...RequestOption,
+) ([]*LiteWorkItem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workitem *LiteWorkItem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*LiteWorkItem, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.Workitem)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemsCount(
+	ctx context.Context, request *GetLiteWorkItemsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *liteWorkItemService) CreateLiteWorkItemGroup(
+	ctx context.Context, request *CreateLiteWorkItemGroupRequest, opts ...RequestOption,
+) (*LiteWorkItemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		WorkitemGroup *LiteWorkItemGroup `json:"WorkitemGroup"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.WorkitemGroup, resp, nil
+}
+
+func (s *liteWorkItemService) UpdateLiteWorkItemGroup(
+	ctx context.Context, request *UpdateLiteWorkItemGroupRequest, opts ...RequestOption,
+) (*LiteWorkItemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		WorkitemGroup *LiteWorkItemGroup `json:"WorkitemGroup"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.WorkitemGroup, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemGroups(
+	ctx context.Context, request *GetLiteWorkItemGroupsRequest, opts ...RequestOption,
+) ([]*LiteWorkItemGroup, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_groups", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WorkitemGroup *LiteWorkItemGroup `json:"WorkitemGroup"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*LiteWorkItemGroup, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.WorkitemGroup)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemGroupsCount(
+	ctx context.Context, request *GetLiteWorkItemGroupsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_groups/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemChanges(
+	ctx context.Context, request *GetLiteWorkItemChangesRequest, opts ...RequestOption,
+) ([]*LiteWorkItemChange, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_changes", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		WorkitemChange *LiteWorkItemChange `json:"WorkitemChange"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*LiteWorkItemChange, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.WorkitemChange)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemChangesCount(
+	ctx context.Context, request *GetLiteWorkItemChangesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitem_changes/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemCustomFieldsSettings(
+	ctx context.Context, request *GetLiteWorkItemCustomFieldsSettingsRequest, opts ...RequestOption,
+) ([]*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/custom_fields_settings", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*CustomFieldConfig, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.CustomFieldConfig)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemFieldsLabel(
+	ctx context.Context, request *GetLiteWorkItemFieldsLabelRequest, opts ...RequestOption,
+) ([]*LiteWorkItemFieldLabel, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_fields_label", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var labelsMap map[string]string
+	resp, err := s.client.Do(req, &labelsMap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	labels := make([]*LiteWorkItemFieldLabel, 0, len(labelsMap))
+	for en, cn := range labelsMap {
+		labels = append(labels, &LiteWorkItemFieldLabel{
+			EN: en,
+			CN: cn,
+		})
+	}
+
+	return labels, resp, nil
+}
+
+func (s *liteWorkItemService) CreateLiteWorkItemRelation(
+	ctx context.Context, request *CreateLiteWorkItemRelationRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems/create_relation", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemRelatedStories(
+	ctx context.Context, request *GetLiteWorkItemRelatedStoriesRequest, opts ...RequestOption,
+) ([]*Story, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_related_stories", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Story *Story `json:"Story"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*Story, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.Story)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) GetLiteWorkItemRelatedBugs(
+	ctx context.Context, request *GetLiteWorkItemRelatedBugsRequest, opts ...RequestOption,
+) ([]*Bug, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_related_bugs", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Bug *Bug `json:"Bug"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*Bug, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.Bug)
+	}
+
+	return results, resp, nil
+}
+
+func (s *liteWorkItemService) RemoveLiteWorkItemRelation(
+	ctx context.Context, request *RemoveLiteWorkItemRelationRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "lite/workitems/remove_relation", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *liteWorkItemService) GetRemovedLiteWorkItems(
+	ctx context.Context, request *GetRemovedLiteWorkItemsRequest, opts ...RequestOption,
+) ([]*LiteWorkItem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "lite/workitems/get_removed_workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workitem *LiteWorkItem `json:"Workitem"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := make([]*LiteWorkItem, 0, len(items))
+	for _, item := range items {
+		results = append(results, item.Workitem)
+	}
+
+	return results, resp, nil
+}
+
+func (w *LiteWorkItem) UnmarshalJSON(data []byte) error {
+	type alias LiteWorkItem
+	if err := json.Unmarshal(data, (*alias)(w)); err != nil {
+		return err
+	}
+
+	fields, err := ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	w.CustomFields = fields
+
+	return nil
+}
+
+func (r CreateLiteWorkItemRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateLiteWorkItemRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
+
+func (r UpdateLiteWorkItemRequest) MarshalJSON() ([]byte, error) {
+	type alias UpdateLiteWorkItemRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
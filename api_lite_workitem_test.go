@@ -0,0 +1,334 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiteWorkItemService_CreateLiteWorkItem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(66667777), req["workspace_id"])
+		assert.Equal(t, "整理周会纪要", req["name"])
+		assert.Equal(t, "张三;", req["owner"])
+		assert.Equal(t, float64(1166667777001000101), req["group_id"])
+		assert.Equal(t, "会议", req["custom_field_one"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/create_lite_workitem.json"))
+	}))
+
+	item, _, err := client.LiteWorkItemService.CreateLiteWorkItem(ctx, &CreateLiteWorkItemRequest{
+		WorkspaceID:  new(66667777),
+		Name:         new("整理周会纪要"),
+		Owner:        new("张三;"),
+		GroupID:      new(int64(1166667777001000101)),
+		CustomFields: CustomFields{"custom_field_one": "会议"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1166667777001000001", item.ID)
+	assert.Equal(t, "progressing", item.Status)
+	assert.Equal(t, "2026-10-16", item.Due)
+	assert.Equal(t, CustomFields{"custom_field_one": "会议", "custom_field_two": ""}, item.CustomFields)
+}
+
+func TestLiteWorkItemService_UpdateLiteWorkItem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1166667777001000001), req["id"])
+		assert.Equal(t, "done", req["status"])
+		assert.Equal(t, "张三", req["current_user"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/update_lite_workitem.json"))
+	}))
+
+	item, _, err := client.LiteWorkItemService.UpdateLiteWorkItem(ctx, &UpdateLiteWorkItemRequest{
+		ID:          new(int64(1166667777001000001)),
+		WorkspaceID: new(66667777),
+		Status:      new("done"),
+		CurrentUser: new("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "done", item.Status)
+	assert.Equal(t, "2026-10-14 18:00:00", item.Completed)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "open|progressing", r.URL.Query().Get("status"))
+		assert.Equal(t, "id,name,status", r.URL.Query().Get("fields"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitems.json"))
+	}))
+
+	items, _, err := client.LiteWorkItemService.GetLiteWorkItems(ctx, &GetLiteWorkItemsRequest{
+		WorkspaceID: new(66667777),
+		Status:      In("open", "progressing"),
+		Fields:      NewMulti("id", "name", "status"),
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "整理周会纪要", items[0].Name)
+	assert.Equal(t, "准备季度汇报", items[1].Name)
+	assert.Equal(t, "李四;", items[1].Owner)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/count", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "张三", r.URL.Query().Get("creator"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitems_count.json"))
+	}))
+
+	count, _, err := client.LiteWorkItemService.GetLiteWorkItemsCount(ctx, &GetLiteWorkItemsCountRequest{
+		WorkspaceID: new(66667777),
+		Creator:     new("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestLiteWorkItemService_CreateLiteWorkItemGroup(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitem_groups", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(66667777), req["workspace_id"])
+		assert.Equal(t, "会议", req["name"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/create_lite_workitem_group.json"))
+	}))
+
+	group, _, err := client.LiteWorkItemService.CreateLiteWorkItemGroup(ctx, &CreateLiteWorkItemGroupRequest{
+		WorkspaceID: new(66667777),
+		Name:        new("会议"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1166667777001000101", group.ID)
+	assert.Equal(t, "会议", group.Name)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemGroups(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_groups", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_groups.json"))
+	}))
+
+	groups, _, err := client.LiteWorkItemService.GetLiteWorkItemGroups(ctx, &GetLiteWorkItemGroupsRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, "汇报", groups[1].Name)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemGroupsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_groups/count", r.URL.Path)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_groups_count.json"))
+	}))
+
+	count, _, err := client.LiteWorkItemService.GetLiteWorkItemGroupsCount(ctx, &GetLiteWorkItemGroupsCountRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemChanges(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_changes", r.URL.Path)
+		assert.Equal(t, "1166667777001000001", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_changes.json"))
+	}))
+
+	changes, _, err := client.LiteWorkItemService.GetLiteWorkItemChanges(ctx, &GetLiteWorkItemChangesRequest{
+		WorkspaceID: new(66667777),
+		WorkitemID:  new(int64(1166667777001000001)),
+	})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "update", changes[0].ChangeType)
+	require.Len(t, changes[0].FieldChanges, 1)
+	assert.Equal(t, "status", changes[0].FieldChanges[0].Field)
+	assert.Equal(t, "open", changes[0].FieldChanges[0].ValueBefore)
+	assert.Equal(t, "progressing", changes[0].FieldChanges[0].ValueAfter)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemChangesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitem_changes/count", r.URL.Path)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_changes_count.json"))
+	}))
+
+	count, _, err := client.LiteWorkItemService.GetLiteWorkItemChangesCount(ctx, &GetLiteWorkItemChangesCountRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemCustomFieldsSettings(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_custom_fields_settings.json"))
+	}))
+
+	settings, _, err := client.LiteWorkItemService.GetLiteWorkItemCustomFieldsSettings(ctx, &GetLiteWorkItemCustomFieldsSettingsRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	assert.Equal(t, "custom_field_one", settings[0].CustomField)
+	assert.Equal(t, "会议|汇报", *settings[0].Options)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemFieldsLabel(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_fields_label", r.URL.Path)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_fields_label.json"))
+	}))
+
+	labels, _, err := client.LiteWorkItemService.GetLiteWorkItemFieldsLabel(ctx, &GetLiteWorkItemFieldsLabelRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*LiteWorkItemFieldLabel{
+		{EN: "name", CN: "标题"},
+		{EN: "status", CN: "状态"},
+		{EN: "owner", CN: "处理人"},
+		{EN: "custom_field_one", CN: "类型"},
+	}, labels)
+}
+
+func TestLiteWorkItemService_CreateLiteWorkItemRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems/create_relation", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1166667777001000001), req["workitem_id"])
+		assert.Equal(t, "story", req["target_type"])
+		assert.Equal(t, "1111112222001000001,1111112222001000002", req["target_ids"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/create_lite_workitem_relation.json"))
+	}))
+
+	ok, _, err := client.LiteWorkItemService.CreateLiteWorkItemRelation(ctx, &CreateLiteWorkItemRelationRequest{
+		WorkspaceID: new(66667777),
+		WorkitemID:  new(int64(1166667777001000001)),
+		TargetType:  new(EntityTypeStory),
+		TargetIDs:   NewMulti[int64](1111112222001000001, 1111112222001000002),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestLiteWorkItemService_RemoveLiteWorkItemRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/lite/workitems/remove_relation", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "bug", req["target_type"])
+		assert.Equal(t, "1111112222001000501", req["target_ids"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/remove_lite_workitem_relation.json"))
+	}))
+
+	ok, _, err := client.LiteWorkItemService.RemoveLiteWorkItemRelation(ctx, &RemoveLiteWorkItemRelationRequest{
+		WorkspaceID: new(66667777),
+		WorkitemID:  new(int64(1166667777001000001)),
+		TargetType:  new(EntityTypeBug),
+		TargetIDs:   NewMulti[int64](1111112222001000501),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemRelatedStories(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_related_stories", r.URL.Path)
+		assert.Equal(t, "1166667777001000001", r.URL.Query().Get("workitem_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_related_stories.json"))
+	}))
+
+	stories, _, err := client.LiteWorkItemService.GetLiteWorkItemRelatedStories(ctx, &GetLiteWorkItemRelatedStoriesRequest{
+		WorkspaceID: new(66667777),
+		WorkitemID:  new(int64(1166667777001000001)),
+	})
+	require.NoError(t, err)
+	require.Len(t, stories, 1)
+	assert.Equal(t, "登录功能", stories[0].Name)
+}
+
+func TestLiteWorkItemService_GetLiteWorkItemRelatedBugs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_related_bugs", r.URL.Path)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_lite_workitem_related_bugs.json"))
+	}))
+
+	bugs, _, err := client.LiteWorkItemService.GetLiteWorkItemRelatedBugs(ctx, &GetLiteWorkItemRelatedBugsRequest{
+		WorkspaceID: new(66667777),
+		WorkitemID:  new(int64(1166667777001000001)),
+	})
+	require.NoError(t, err)
+	require.Len(t, bugs, 1)
+	assert.Equal(t, "登录失败", bugs[0].Title)
+}
+
+func TestLiteWorkItemService_GetRemovedLiteWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/lite/workitems/get_removed_workitems", r.URL.Path)
+		assert.Equal(t, "66667777", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/lite_workitem/get_removed_lite_workitems.json"))
+	}))
+
+	items, _, err := client.LiteWorkItemService.GetRemovedLiteWorkItems(ctx, &GetRemovedLiteWorkItemsRequest{
+		WorkspaceID: new(66667777),
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "已删除的工作项", items[0].Name)
+}
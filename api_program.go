@@ -0,0 +1,140 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+type (
+	GetProgramViewWorkItemsRequest struct {
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目集ID
+		ViewConfID  *int64  `url:"view_conf_id,omitempty"` // [必须]视图ID
+		CurrentUser *string `url:"current_user,omitempty"` // 当前登录用户视图
+		Limit       *int    `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int    `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+	}
+
+	BatchLinkProgramWorkspacesRequest struct {
+		WorkspaceID        *int        `json:"workspace_id,omitempty"`         // [必须]项目集ID
+		LinkWorkspaceIDs   *Multi[int] `json:"link_workspace_ids,omitempty"`   // 关联的项目ID
+		UnlinkWorkspaceIDs *Multi[int] `json:"unlink_workspace_ids,omitempty"` // 取消关联的项目ID
+		AuthWorkspaceIDs   *Multi[int] `json:"auth_workspace_ids,omitempty"`   // 授权范围内的项目ID，覆盖原有授权范围
+		Operator           *string     `json:"operator,omitempty"`             // 操作人
+	}
+
+	BatchLinkProgramWorkItemsRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目集ID
+		EntityType  *EntityType   `json:"entity_type,omitempty"`  // [必须]业务对象类型，story、bug、task
+		LinkIDs     *Multi[int64] `json:"link_ids,omitempty"`     // 关联的业务对象ID
+		UnlinkIDs   *Multi[int64] `json:"unlink_ids,omitempty"`   // 取消关联的业务对象ID
+		Operator    *string       `json:"operator,omitempty"`     // 操作人
+	}
+)
+
+// ProgramService 项目集
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/program/
+type ProgramService interface {
+	// GetProgramViewWorkItems 根据视图id获取项目集视图工作项列表
+	//
+	// 视图可同时包含需求、缺陷和任务，返回的工作项为 *Story、*Bug 或 *Task。
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/program/get_view_workitems.html
+	GetProgramViewWorkItems(ctx context.Context, request *GetProgramViewWorkItemsRequest, opts ...RequestOption) ([]WorkItem, *Response, error)
+
+	// BatchLinkProgramWorkspaces 项目集批量关联/取消关联、修改授权范围项目
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/program/batch_link_workspaces.html
+	BatchLinkProgramWorkspaces(ctx context.Context, request *BatchLinkProgramWorkspacesRequest, opts ...RequestOption) (bool, *Response, error)
+
+	// BatchLinkProgramWorkItems 项目集批量关联/取消关联业务对象
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/program/batch_link_workitems.html
+	BatchLinkProgramWorkItems(ctx context.Context, request *BatchLinkProgramWorkItemsRequest, opts ...RequestOption) (bool, *Response, error)
+}
+
+type programService struct {
+	client *Client
+}
+
+var _ ProgramService = (*programService)(nil)
+
+func NewProgramService(client *Client) ProgramService {
+	return &programService{
+		client: client,
+	}
+}
+
+func (s *programService) GetProgramViewWorkItems(
+	ctx context.Context, request *GetProgramViewWorkItemsRequest, opts ...RequestOption,
+) ([]WorkItem, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "programs/get_workitems_by_view_conf_id", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []map[string]json.RawMessage
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workItems := make([]WorkItem, 0, len(items))
+	for _, item := range items {
+		for key, data := range item {
+			var workItem WorkItem
+			switch strings.ToLower(key) {
+			case string(EntityTypeStory):
+				workItem = new(Story)
+			case string(EntityTypeBug):
+				workItem = new(Bug)
+			case string(EntityTypeTask):
+				workItem = new(Task)
+			default:
+				continue
+			}
+			if err := json.Unmarshal(data, workItem); err != nil {
+				return nil, resp, err
+			}
+			workItems = append(workItems, workItem)
+		}
+	}
+
+	return workItems, resp, nil
+}
+
+func (s *programService) BatchLinkProgramWorkspaces(
+	ctx context.Context, request *BatchLinkProgramWorkspacesRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "programs/batch_link_workspaces", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *programService) BatchLinkProgramWorkItems(
+	ctx context.Context, request *BatchLinkProgramWorkItemsRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "programs/batch_link_workitems", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
@@ -0,0 +1,92 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgramService_GetProgramViewWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/programs/get_workitems_by_view_conf_id", r.URL.Path)
+		assert.Equal(t, "55556666", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1155556666001000001", r.URL.Query().Get("view_conf_id"))
+		assert.Equal(t, "张三", r.URL.Query().Get("current_user"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/program/get_program_view_workitems.json"))
+	}))
+
+	items, _, err := client.ProgramService.GetProgramViewWorkItems(ctx, &GetProgramViewWorkItemsRequest{
+		WorkspaceID: new(55556666),
+		ViewConfID:  new(int64(1155556666001000001)),
+		CurrentUser: new("张三"),
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, EntityTypeStory, items[0].GetEntityType())
+	assert.Equal(t, "项目集需求", items[0].GetName())
+	assert.Equal(t, EntityTypeBug, items[1].GetEntityType())
+	assert.Equal(t, "11113333", items[1].GetWorkspaceID())
+	assert.Equal(t, "李四;", items[1].GetOwner())
+	assert.Equal(t, EntityTypeTask, items[2].GetEntityType())
+
+	task, ok := items[2].(*Task)
+	require.True(t, ok)
+	assert.Equal(t, "1111112222001000603", task.ID)
+}
+
+func TestProgramService_BatchLinkProgramWorkspaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/programs/batch_link_workspaces", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(55556666), req["workspace_id"])
+		assert.Equal(t, "11112222,11113333", req["link_workspace_ids"])
+		assert.Equal(t, "11114444", req["unlink_workspace_ids"])
+		assert.Equal(t, "11112222", req["auth_workspace_ids"])
+		assert.Equal(t, "张三", req["operator"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/program/batch_link_program_workspaces.json"))
+	}))
+
+	ok, _, err := client.ProgramService.BatchLinkProgramWorkspaces(ctx, &BatchLinkProgramWorkspacesRequest{
+		WorkspaceID:        new(55556666),
+		LinkWorkspaceIDs:   NewMulti(11112222, 11113333),
+		UnlinkWorkspaceIDs: NewMulti(11114444),
+		AuthWorkspaceIDs:   NewMulti(11112222),
+		Operator:           new("张三"),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestProgramService_BatchLinkProgramWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/programs/batch_link_workitems", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(55556666), req["workspace_id"])
+		assert.Equal(t, "story", req["entity_type"])
+		assert.Equal(t, "1111112222001000601,1111112222001000604", req["link_ids"])
+		assert.Nil(t, req["unlink_ids"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/program/batch_link_program_workitems.json"))
+	}))
+
+	ok, _, err := client.ProgramService.BatchLinkProgramWorkItems(ctx, &BatchLinkProgramWorkItemsRequest{
+		WorkspaceID: new(55556666),
+		EntityType:  new(EntityTypeStory),
+		LinkIDs:     NewMulti[int64](1111112222001000601, 1111112222001000604),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -15,35 +15,400 @@ type (
 		IsEnabledStoryCategory *int    `json:"is_enabled_story_category,omitempty"` // 是否启用需求分类树（1启用，0未启用 ）
 		WorkspaceMetrology     *string `json:"workspace_metrology,omitempty"`       // 工时单位（day 天，hour 小时）
 	}
+
+	// Module 模块
+	Module struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+		Name        string `json:"name,omitempty"`         // 名称
+		Description string `json:"description,omitempty"`  // 描述
+		Owner       string `json:"owner,omitempty"`        // 负责人
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	CreateModuleRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // [必须]名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Owner       *string `json:"owner,omitempty"`        // 负责人
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetModulesRequest struct {
+		ID          Filter         `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string        `url:"name,omitempty"`         // 名称
+		Owner       *string        `url:"owner,omitempty"`        // 负责人
+		Creator     *string        `url:"creator,omitempty"`      // 创建人
+		Created     Filter         `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetModulesCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `url:"name,omitempty"`         // 名称
+		Owner       *string `url:"owner,omitempty"`        // 负责人
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+	}
+
+	UpdateModuleRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // 名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Owner       *string `json:"owner,omitempty"`        // 负责人
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+	}
+
+	// Version 版本
+	Version struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+		Name        string `json:"name,omitempty"`         // 名称
+		Description string `json:"description,omitempty"`  // 描述
+		Status      string `json:"status,omitempty"`       // 状态
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	CreateVersionRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // [必须]名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetVersionsRequest struct {
+		ID          Filter         `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string        `url:"name,omitempty"`         // 名称
+		Status      *string        `url:"status,omitempty"`       // 状态
+		Creator     *string        `url:"creator,omitempty"`      // 创建人
+		Created     Filter         `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetVersionsCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `url:"name,omitempty"`         // 名称
+		Status      *string `url:"status,omitempty"`       // 状态
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+	}
+
+	UpdateVersionRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // 名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+	}
+
+	// Baseline 基线
+	Baseline struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+		Name        string `json:"name,omitempty"`         // 名称
+		Description string `json:"description,omitempty"`  // 描述
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	CreateBaselineRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // [必须]名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetBaselinesRequest struct {
+		ID          Filter         `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string        `url:"name,omitempty"`         // 名称
+		Creator     *string        `url:"creator,omitempty"`      // 创建人
+		Created     Filter         `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetBaselinesCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `url:"name,omitempty"`         // 名称
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+	}
+
+	UpdateBaselineRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // 名称
+		Description *string `json:"description,omitempty"`  // 描述
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+	}
+
+	// Feature 特性
+	Feature struct {
+		ID          string `json:"id,omitempty"`           // ID
+		WorkspaceID string `json:"workspace_id,omitempty"` // 项目ID
+		Name        string `json:"name,omitempty"`         // 名称
+		Description string `json:"description,omitempty"`  // 描述
+		Status      string `json:"status,omitempty"`       // 状态
+		Creator     string `json:"creator,omitempty"`      // 创建人
+		Created     string `json:"created,omitempty"`      // 创建时间
+		Modifier    string `json:"modifier,omitempty"`     // 最后修改人
+		Modified    string `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	CreateFeatureRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // [必须]名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetFeaturesRequest struct {
+		ID          Filter         `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int           `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string        `url:"name,omitempty"`         // 名称
+		Status      *string        `url:"status,omitempty"`       // 状态
+		Creator     *string        `url:"creator,omitempty"`      // 创建人
+		Created     Filter         `url:"created,omitempty"`      // 创建时间 支持时间查询
+		Limit       *int           `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int           `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] `url:"fields,omitempty"`       // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetFeaturesCountRequest struct {
+		ID          Filter  `url:"id,omitempty"`           // ID 支持多ID查询
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `url:"name,omitempty"`         // 名称
+		Status      *string `url:"status,omitempty"`       // 状态
+		Creator     *string `url:"creator,omitempty"`      // 创建人
+		Created     Filter  `url:"created,omitempty"`      // 创建时间 支持时间查询
+	}
+
+	UpdateFeatureRequest struct {
+		ID          *int64  `json:"id,omitempty"`           // [必须]ID
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		Name        *string `json:"name,omitempty"`         // 名称
+		Description *string `json:"description,omitempty"`  // 描述
+		Status      *string `json:"status,omitempty"`       // 状态
+		CurrentUser *string `json:"current_user,omitempty"` // 变更人
+	}
+
+	// CustomFieldConfig 自定义字段配置
+	CustomFieldConfig struct {
+		ID          string  `json:"id,omitempty"`           // 自定义字段配置的ID
+		WorkspaceID string  `json:"workspace_id,omitempty"` // 所属项目ID
+		AppID       string  `json:"app_id,omitempty"`       // 应用ID
+		EntryType   string  `json:"entry_type,omitempty"`   // 所属实体对象
+		CustomField string  `json:"custom_field,omitempty"` // 自定义字段标识（英文名）
+		Type        string  `json:"type,omitempty"`         // 输入类型
+		Name        string  `json:"name,omitempty"`         // 自定义字段显示名称
+		Options     *string `json:"options,omitempty"`      // 自定义字段可选值
+		ExtraConfig *string `json:"extra_config,omitempty"` // 额外配置
+		Enabled     string  `json:"enabled,omitempty"`      // 是否启用
+		Freeze      string  `json:"freeze,omitempty"`       // 是否冻结
+		Sort        *string `json:"sort,omitempty"`         // 显示时排序系数
+		Memo        *string `json:"memo,omitempty"`         // 备注
+	}
+
+	CreateCustomFieldRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+		EntryType   *EntityType   `json:"entry_type,omitempty"`   // [必须]所属实体对象，story 需求，bug 缺陷
+		Name        *string       `json:"name,omitempty"`         // [必须]自定义字段显示名称
+		Type        *string       `json:"type,omitempty"`         // [必须]输入类型，如 text、textarea、select、multi_select、checkbox、datetime、user_chooser
+		Options     *Enum[string] `json:"options,omitempty"`      // 候选值，下拉类型字段必填
+		Memo        *string       `json:"memo,omitempty"`         // 备注
+		Creator     *string       `json:"creator,omitempty"`      // 创建人
+	}
+
+	UpdateCustomFieldOptionsRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+		EntryType   *EntityType   `json:"entry_type,omitempty"`   // [必须]所属实体对象，story 需求，bug 缺陷
+		CustomField *string       `json:"custom_field,omitempty"` // [必须]自定义字段标识，如 custom_field_1
+		Options     *Enum[string] `json:"options,omitempty"`      // [必须]全部候选值，覆盖原有候选值
+	}
+
+	UpdateStoryCustomFieldOptionsRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+		CustomField *string       `json:"custom_field,omitempty"` // [必须]自定义字段标识，如 custom_field_1
+		Options     *Enum[string] `json:"options,omitempty"`      // [必须]全部候选值，覆盖原有候选值
+	}
+
+	UpdateBugCustomFieldOptionsRequest struct {
+		WorkspaceID *int          `json:"workspace_id,omitempty"` // [必须]项目ID
+		CustomField *string       `json:"custom_field,omitempty"` // [必须]自定义字段标识，如 custom_field_1
+		Options     *Enum[string] `json:"options,omitempty"`      // [必须]全部候选值，覆盖原有候选值
+	}
+
+	// CascadeOption 级联字段候选值，Children 为下一级候选值
+	CascadeOption struct {
+		Label    string           `json:"label"`              // 候选值
+		Children []*CascadeOption `json:"children,omitempty"` // 下一级候选值
+	}
+
+	UpdateCascadeCustomFieldOptionsRequest struct {
+		WorkspaceID *int             `json:"workspace_id,omitempty"` // [必须]项目ID
+		EntryType   *EntityType      `json:"entry_type,omitempty"`   // [必须]所属实体对象，story 需求，bug 缺陷
+		CustomField *string          `json:"custom_field,omitempty"` // [必须]自定义字段标识，如 custom_field_1
+		Options     []*CascadeOption `json:"options,omitempty"`      // [必须]全部候选值树，覆盖原有候选值
+	}
+
+	CopyStoryWorkitemTypeRequest struct {
+		WorkspaceID    *int    `json:"workspace_id,omitempty"`     // [必须]目标项目ID
+		SrcWorkspaceID *int    `json:"src_workspace_id,omitempty"` // [必须]源项目ID
+		WorkitemTypeID *int64  `json:"workitem_type_id,omitempty"` // [必须]源项目的需求类别ID
+		Creator        *string `json:"creator,omitempty"`          // 创建人
+	}
+
+	CopyBugConfigRequest struct {
+		WorkspaceID    *int    `json:"workspace_id,omitempty"`     // [必须]目标项目ID
+		SrcWorkspaceID *int    `json:"src_workspace_id,omitempty"` // [必须]源项目ID
+		Creator        *string `json:"creator,omitempty"`          // 创建人
+	}
 )
 
 // SettingService 配置
 //
 // https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/
 type SettingService interface {
-	// 创建自定义字段（需求及缺陷）
-	// 更新下拉类型自定义字段候选值
-	// 更新需求下拉类型自定义字段候选值
-	// 更新缺陷下拉类型自定义字段候选值
-	// 更新级联自定义字段侯选值
-	// 创建模块接口
-	// 创建版本接口
-	// 获取模块接口
-	// 获取模块数量接口
-	// 获取版本接口
-	// 获取版本数量接口
-	// 更新模块接口
-	// 创建基线接口
-	// 创建特性接口
-	// 复制需求类别接口
-	// 复制缺陷配置接口
-	// 更新基线接口
-	// 更新特性接口
-	// 获取特性接口
-	// 获取特性数量接口
-	// 获取基线接口
-	// 获取基线数量接口
-	// 更新版本接口
+	// CreateCustomField 创建自定义字段（需求及缺陷）
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/create_custom_field.html
+	CreateCustomField(ctx context.Context, request *CreateCustomFieldRequest, opts ...RequestOption) (*CustomFieldConfig, *Response, error)
+
+	// UpdateCustomFieldOptions 更新下拉类型自定义字段候选值
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_custom_field_options.html
+	UpdateCustomFieldOptions(ctx context.Context, request *UpdateCustomFieldOptionsRequest, opts ...RequestOption) (*CustomFieldConfig, *Response, error)
+
+	// UpdateStoryCustomFieldOptions 更新需求下拉类型自定义字段候选值
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_story_custom_field_options.html
+	UpdateStoryCustomFieldOptions(ctx context.Context, request *UpdateStoryCustomFieldOptionsRequest, opts ...RequestOption) (*CustomFieldConfig, *Response, error)
+
+	// UpdateBugCustomFieldOptions 更新缺陷下拉类型自定义字段候选值
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_bug_custom_field_options.html
+	UpdateBugCustomFieldOptions(ctx context.Context, request *UpdateBugCustomFieldOptionsRequest, opts ...RequestOption) (*CustomFieldConfig, *Response, error)
+
+	// UpdateCascadeCustomFieldOptions 更新级联自定义字段侯选值
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_cascade_custom_field_options.html
+	UpdateCascadeCustomFieldOptions(ctx context.Context, request *UpdateCascadeCustomFieldOptionsRequest, opts ...RequestOption) (*CustomFieldConfig, *Response, error)
+
+	// CopyStoryWorkitemType 复制需求类别
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/copy_story_workitem_type.html
+	CopyStoryWorkitemType(ctx context.Context, request *CopyStoryWorkitemTypeRequest, opts ...RequestOption) (*StoryWorkitemType, *Response, error)
+
+	// CopyBugConfig 复制缺陷配置
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/copy_bug_config.html
+	CopyBugConfig(ctx context.Context, request *CopyBugConfigRequest, opts ...RequestOption) (bool, *Response, error)
+
+	// CreateModule 创建模块
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_module.html
+	CreateModule(ctx context.Context, request *CreateModuleRequest, opts ...RequestOption) (*Module, *Response, error)
+
+	// GetModules 获取模块
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_modules.html
+	GetModules(ctx context.Context, request *GetModulesRequest, opts ...RequestOption) ([]*Module, *Response, error)
+
+	// GetModulesCount 获取模块数量
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_modules_count.html
+	GetModulesCount(ctx context.Context, request *GetModulesCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// UpdateModule 更新模块
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_module.html
+	UpdateModule(ctx context.Context, request *UpdateModuleRequest, opts ...RequestOption) (*Module, *Response, error)
+
+	// CreateVersion 创建版本
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_version.html
+	CreateVersion(ctx context.Context, request *CreateVersionRequest, opts ...RequestOption) (*Version, *Response, error)
+
+	// GetVersions 获取版本
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_versions.html
+	GetVersions(ctx context.Context, request *GetVersionsRequest, opts ...RequestOption) ([]*Version, *Response, error)
+
+	// GetVersionsCount 获取版本数量
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_versions_count.html
+	GetVersionsCount(ctx context.Context, request *GetVersionsCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// UpdateVersion 更新版本
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_version.html
+	UpdateVersion(ctx context.Context, request *UpdateVersionRequest, opts ...RequestOption) (*Version, *Response, error)
+
+	// CreateBaseline 创建基线
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_baseline.html
+	CreateBaseline(ctx context.Context, request *CreateBaselineRequest, opts ...RequestOption) (*Baseline, *Response, error)
+
+	// GetBaselines 获取基线
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_baselines.html
+	GetBaselines(ctx context.Context, request *GetBaselinesRequest, opts ...RequestOption) ([]*Baseline, *Response, error)
+
+	// GetBaselinesCount 获取基线数量
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_baselines_count.html
+	GetBaselinesCount(ctx context.Context, request *GetBaselinesCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// UpdateBaseline 更新基线
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_baseline.html
+	UpdateBaseline(ctx context.Context, request *UpdateBaselineRequest, opts ...RequestOption) (*Baseline, *Response, error)
+
+	// CreateFeature 创建特性
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/add_feature.html
+	CreateFeature(ctx context.Context, request *CreateFeatureRequest, opts ...RequestOption) (*Feature, *Response, error)
+
+	// GetFeatures 获取特性
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_features.html
+	GetFeatures(ctx context.Context, request *GetFeaturesRequest, opts ...RequestOption) ([]*Feature, *Response, error)
+
+	// GetFeaturesCount 获取特性数量
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/get_features_count.html
+	GetFeaturesCount(ctx context.Context, request *GetFeaturesCountRequest, opts ...RequestOption) (int, *Response, error)
+
+	// UpdateFeature 更新特性
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/setting/update_feature.html
+	UpdateFeature(ctx context.Context, request *UpdateFeatureRequest, opts ...RequestOption) (*Feature, *Response, error)
 
 	// GetWorkspaceSetting 获取项目配置开关
 	//
@@ -79,3 +444,455 @@ func (s *settingService) GetWorkspaceSetting(
 
 	return response, resp, nil
 }
+
+func (s *settingService) CreateModule(
+	ctx context.Context, request *CreateModuleRequest, opts ...RequestOption,
+) (*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Module, resp, nil
+}
+
+func (s *settingService) GetModules(
+	ctx context.Context, request *GetModulesRequest, opts ...RequestOption,
+) ([]*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	modules := make([]*Module, 0, len(items))
+	for _, item := range items {
+		modules = append(modules, item.Module)
+	}
+
+	return modules, resp, nil
+}
+
+func (s *settingService) GetModulesCount(
+	ctx context.Context, request *GetModulesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "modules/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *settingService) UpdateModule(
+	ctx context.Context, request *UpdateModuleRequest, opts ...RequestOption,
+) (*Module, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "modules", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Module *Module `json:"Module"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Module, resp, nil
+}
+
+func (s *settingService) CreateVersion(
+	ctx context.Context, request *CreateVersionRequest, opts ...RequestOption,
+) (*Version, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Version *Version `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Version, resp, nil
+}
+
+func (s *settingService) GetVersions(
+	ctx context.Context, request *GetVersionsRequest, opts ...RequestOption,
+) ([]*Version, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Version *Version `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	versions := make([]*Version, 0, len(items))
+	for _, item := range items {
+		versions = append(versions, item.Version)
+	}
+
+	return versions, resp, nil
+}
+
+func (s *settingService) GetVersionsCount(
+	ctx context.Context, request *GetVersionsCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "versions/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *settingService) UpdateVersion(
+	ctx context.Context, request *UpdateVersionRequest, opts ...RequestOption,
+) (*Version, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "versions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Version *Version `json:"Version"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Version, resp, nil
+}
+
+func (s *settingService) CreateBaseline(
+	ctx context.Context, request *CreateBaselineRequest, opts ...RequestOption,
+) (*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Baseline, resp, nil
+}
+
+func (s *settingService) GetBaselines(
+	ctx context.Context, request *GetBaselinesRequest, opts ...RequestOption,
+) ([]*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	baselines := make([]*Baseline, 0, len(items))
+	for _, item := range items {
+		baselines = append(baselines, item.Baseline)
+	}
+
+	return baselines, resp, nil
+}
+
+func (s *settingService) GetBaselinesCount(
+	ctx context.Context, request *GetBaselinesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "baselines/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *settingService) UpdateBaseline(
+	ctx context.Context, request *UpdateBaselineRequest, opts ...RequestOption,
+) (*Baseline, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "baselines", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Baseline *Baseline `json:"Baseline"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Baseline, resp, nil
+}
+
+func (s *settingService) CreateFeature(
+	ctx context.Context, request *CreateFeatureRequest, opts ...RequestOption,
+) (*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Feature, resp, nil
+}
+
+func (s *settingService) GetFeatures(
+	ctx context.Context, request *GetFeaturesRequest, opts ...RequestOption,
+) ([]*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	features := make([]*Feature, 0, len(items))
+	for _, item := range items {
+		features = append(features, item.Feature)
+	}
+
+	return features, resp, nil
+}
+
+func (s *settingService) GetFeaturesCount(
+	ctx context.Context, request *GetFeaturesCountRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "features/count", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *settingService) UpdateFeature(
+	ctx context.Context, request *UpdateFeatureRequest, opts ...RequestOption,
+) (*Feature, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "features", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Feature *Feature `json:"Feature"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Feature, resp, nil
+}
+
+func (s *settingService) CreateCustomField(
+	ctx context.Context, request *CreateCustomFieldRequest, opts ...RequestOption,
+) (*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/create_custom_field", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.CustomFieldConfig, resp, nil
+}
+
+func (s *settingService) UpdateCustomFieldOptions(
+	ctx context.Context, request *UpdateCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/update_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.CustomFieldConfig, resp, nil
+}
+
+func (s *settingService) UpdateStoryCustomFieldOptions(
+	ctx context.Context, request *UpdateStoryCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/update_story_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.CustomFieldConfig, resp, nil
+}
+
+func (s *settingService) UpdateBugCustomFieldOptions(
+	ctx context.Context, request *UpdateBugCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/update_bug_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.CustomFieldConfig, resp, nil
+}
+
+func (s *settingService) UpdateCascadeCustomFieldOptions(
+	ctx context.Context, request *UpdateCascadeCustomFieldOptionsRequest, opts ...RequestOption,
+) (*CustomFieldConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/update_cascade_custom_field_options", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		CustomFieldConfig *CustomFieldConfig `json:"CustomFieldConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.CustomFieldConfig, resp, nil
+}
+
+func (s *settingService) CopyStoryWorkitemType(
+	ctx context.Context, request *CopyStoryWorkitemTypeRequest, opts ...RequestOption,
+) (*StoryWorkitemType, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/copy_story_workitem_type", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		WorkitemType *StoryWorkitemType `json:"WorkitemType"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.WorkitemType, resp, nil
+}
+
+func (s *settingService) CopyBugConfig(
+	ctx context.Context, request *CopyBugConfigRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "settings/copy_bug_config", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// Definition returns the custom field definition of the config.
+func (c *CustomFieldConfig) Definition() *CustomFieldDefinition {
+	return newCustomFieldDefinition(c.CustomField, c.Name, c.Type, c.Options, c.Enabled)
+}
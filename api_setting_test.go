@@ -0,0 +1,583 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingService_CreateModule(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "登录模块", req["name"])
+		assert.Equal(t, "张三", req["creator"])
+		assert.Equal(t, "李四", req["owner"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_module.json"))
+	}))
+
+	module, _, err := client.SettingService.CreateModule(ctx, &CreateModuleRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("登录模块"),
+		Creator:     new("张三"),
+		Owner:       new("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000101", module.ID)
+	assert.Equal(t, "11112222", module.WorkspaceID)
+	assert.Equal(t, "登录模块", module.Name)
+	assert.Equal(t, "李四", module.Owner)
+	assert.Equal(t, "张三", module.Creator)
+	assert.Equal(t, "2025-03-01 10:00:00", module.Created)
+}
+
+func TestSettingService_GetModules(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "111,222", r.URL.Query().Get("id"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_modules.json"))
+	}))
+
+	modules, _, err := client.SettingService.GetModules(ctx, &GetModulesRequest{
+		WorkspaceID: new(11112222),
+		ID:          NewMulti(111, 222),
+		Limit:       new(10),
+		Page:        new(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+	assert.Equal(t, "1111112222001000101", modules[0].ID)
+	assert.Equal(t, "登录模块", modules[0].Name)
+}
+
+func TestSettingService_GetModulesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/modules/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "登录模块", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_modules_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetModulesCount(ctx, &GetModulesCountRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("登录模块"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateModule(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/modules", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1111112222001000101), req["id"])
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "updated", req["description"])
+		assert.Equal(t, "李四", req["current_user"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_module.json"))
+	}))
+
+	module, _, err := client.SettingService.UpdateModule(ctx, &UpdateModuleRequest{
+		ID:          new(int64(1111112222001000101)),
+		WorkspaceID: new(11112222),
+		Description: new("updated"),
+		CurrentUser: new("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000101", module.ID)
+	assert.Equal(t, "updated", module.Description)
+}
+
+func TestSettingService_CreateVersion(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "v1.0", req["name"])
+		assert.Equal(t, "张三", req["creator"])
+		assert.Equal(t, "open", req["status"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_version.json"))
+	}))
+
+	version, _, err := client.SettingService.CreateVersion(ctx, &CreateVersionRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("v1.0"),
+		Creator:     new("张三"),
+		Status:      new("open"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000201", version.ID)
+	assert.Equal(t, "11112222", version.WorkspaceID)
+	assert.Equal(t, "v1.0", version.Name)
+	assert.Equal(t, "open", version.Status)
+	assert.Equal(t, "张三", version.Creator)
+	assert.Equal(t, "2025-03-01 10:00:00", version.Created)
+}
+
+func TestSettingService_GetVersions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "111,222", r.URL.Query().Get("id"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_versions.json"))
+	}))
+
+	versions, _, err := client.SettingService.GetVersions(ctx, &GetVersionsRequest{
+		WorkspaceID: new(11112222),
+		ID:          NewMulti(111, 222),
+		Limit:       new(10),
+		Page:        new(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "1111112222001000201", versions[0].ID)
+	assert.Equal(t, "v1.0", versions[0].Name)
+}
+
+func TestSettingService_GetVersionsCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/versions/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "v1.0", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_versions_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetVersionsCount(ctx, &GetVersionsCountRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("v1.0"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestSettingService_UpdateVersion(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/versions", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1111112222001000201), req["id"])
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "updated", req["description"])
+		assert.Equal(t, "李四", req["current_user"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_version.json"))
+	}))
+
+	version, _, err := client.SettingService.UpdateVersion(ctx, &UpdateVersionRequest{
+		ID:          new(int64(1111112222001000201)),
+		WorkspaceID: new(11112222),
+		Description: new("updated"),
+		CurrentUser: new("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000201", version.ID)
+	assert.Equal(t, "updated", version.Description)
+}
+
+func TestSettingService_CreateBaseline(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "基线一", req["name"])
+		assert.Equal(t, "张三", req["creator"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_baseline.json"))
+	}))
+
+	baseline, _, err := client.SettingService.CreateBaseline(ctx, &CreateBaselineRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("基线一"),
+		Creator:     new("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000301", baseline.ID)
+	assert.Equal(t, "11112222", baseline.WorkspaceID)
+	assert.Equal(t, "基线一", baseline.Name)
+	assert.Equal(t, "张三", baseline.Creator)
+	assert.Equal(t, "2025-03-01 10:00:00", baseline.Created)
+}
+
+func TestSettingService_GetBaselines(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "111,222", r.URL.Query().Get("id"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_baselines.json"))
+	}))
+
+	baselines, _, err := client.SettingService.GetBaselines(ctx, &GetBaselinesRequest{
+		WorkspaceID: new(11112222),
+		ID:          NewMulti(111, 222),
+		Limit:       new(10),
+		Page:        new(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, baselines, 1)
+	assert.Equal(t, "1111112222001000301", baselines[0].ID)
+	assert.Equal(t, "基线一", baselines[0].Name)
+}
+
+func TestSettingService_GetBaselinesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/baselines/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "基线一", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_baselines_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetBaselinesCount(ctx, &GetBaselinesCountRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("基线一"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestSettingService_UpdateBaseline(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/baselines", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1111112222001000301), req["id"])
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "updated", req["description"])
+		assert.Equal(t, "李四", req["current_user"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_baseline.json"))
+	}))
+
+	baseline, _, err := client.SettingService.UpdateBaseline(ctx, &UpdateBaselineRequest{
+		ID:          new(int64(1111112222001000301)),
+		WorkspaceID: new(11112222),
+		Description: new("updated"),
+		CurrentUser: new("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000301", baseline.ID)
+	assert.Equal(t, "updated", baseline.Description)
+}
+
+func TestSettingService_CreateFeature(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "扫码登录", req["name"])
+		assert.Equal(t, "张三", req["creator"])
+		assert.Equal(t, "open", req["status"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_feature.json"))
+	}))
+
+	feature, _, err := client.SettingService.CreateFeature(ctx, &CreateFeatureRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("扫码登录"),
+		Creator:     new("张三"),
+		Status:      new("open"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000401", feature.ID)
+	assert.Equal(t, "11112222", feature.WorkspaceID)
+	assert.Equal(t, "扫码登录", feature.Name)
+	assert.Equal(t, "open", feature.Status)
+	assert.Equal(t, "张三", feature.Creator)
+	assert.Equal(t, "2025-03-01 10:00:00", feature.Created)
+}
+
+func TestSettingService_GetFeatures(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "111,222", r.URL.Query().Get("id"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_features.json"))
+	}))
+
+	features, _, err := client.SettingService.GetFeatures(ctx, &GetFeaturesRequest{
+		WorkspaceID: new(11112222),
+		ID:          NewMulti(111, 222),
+		Limit:       new(10),
+		Page:        new(1),
+	})
+	require.NoError(t, err)
+	require.Len(t, features, 1)
+	assert.Equal(t, "1111112222001000401", features[0].ID)
+	assert.Equal(t, "扫码登录", features[0].Name)
+}
+
+func TestSettingService_GetFeaturesCount(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/features/count", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "扫码登录", r.URL.Query().Get("name"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_features_count.json"))
+	}))
+
+	count, _, err := client.SettingService.GetFeaturesCount(ctx, &GetFeaturesCountRequest{
+		WorkspaceID: new(11112222),
+		Name:        new("扫码登录"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestSettingService_UpdateFeature(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/features", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1111112222001000401), req["id"])
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "updated", req["description"])
+		assert.Equal(t, "李四", req["current_user"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_feature.json"))
+	}))
+
+	feature, _, err := client.SettingService.UpdateFeature(ctx, &UpdateFeatureRequest{
+		ID:          new(int64(1111112222001000401)),
+		WorkspaceID: new(11112222),
+		Description: new("updated"),
+		CurrentUser: new("李四"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000401", feature.ID)
+	assert.Equal(t, "updated", feature.Description)
+}
+
+func TestSettingService_CreateCustomField(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/create_custom_field", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "story", req["entry_type"])
+		assert.Equal(t, "发布渠道", req["name"])
+		assert.Equal(t, "select", req["type"])
+		assert.Equal(t, "应用商店|官网", req["options"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/create_custom_field.json"))
+	}))
+
+	config, _, err := client.SettingService.CreateCustomField(ctx, &CreateCustomFieldRequest{
+		WorkspaceID: new(11112222),
+		EntryType:   new(EntityTypeStory),
+		Name:        new("发布渠道"),
+		Type:        new("select"),
+		Options:     NewEnum("应用商店", "官网"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "custom_field_101", config.CustomField)
+	assert.Equal(t, "发布渠道", config.Name)
+
+	definition := config.Definition()
+	assert.True(t, definition.Enabled)
+	require.Len(t, definition.Options, 2)
+	assert.Equal(t, "官网", definition.Options[1].Label)
+}
+
+func TestSettingService_UpdateCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/update_custom_field_options", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "bug", req["entry_type"])
+		assert.Equal(t, "custom_field_101", req["custom_field"])
+		assert.Equal(t, "应用商店|官网|小程序", req["options"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_custom_field_options.json"))
+	}))
+
+	config, _, err := client.SettingService.UpdateCustomFieldOptions(ctx, &UpdateCustomFieldOptionsRequest{
+		WorkspaceID: new(11112222),
+		EntryType:   new(EntityTypeBug),
+		CustomField: new("custom_field_101"),
+		Options:     NewEnum("应用商店", "官网", "小程序"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "应用商店|官网|小程序", *config.Options)
+}
+
+func TestSettingService_UpdateStoryCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/update_story_custom_field_options", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "custom_field_101", req["custom_field"])
+		assert.Equal(t, "应用商店|官网|小程序", req["options"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_story_custom_field_options.json"))
+	}))
+
+	config, _, err := client.SettingService.UpdateStoryCustomFieldOptions(ctx, &UpdateStoryCustomFieldOptionsRequest{
+		WorkspaceID: new(11112222),
+		CustomField: new("custom_field_101"),
+		Options:     NewEnum("应用商店", "官网", "小程序"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "story", config.EntryType)
+	assert.Equal(t, "应用商店|官网|小程序", *config.Options)
+}
+
+func TestSettingService_UpdateBugCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/update_bug_custom_field_options", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "custom_field_8", req["custom_field"])
+		assert.Equal(t, "测试|线上", req["options"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_bug_custom_field_options.json"))
+	}))
+
+	config, _, err := client.SettingService.UpdateBugCustomFieldOptions(ctx, &UpdateBugCustomFieldOptionsRequest{
+		WorkspaceID: new(11112222),
+		CustomField: new("custom_field_8"),
+		Options:     NewEnum("测试", "线上"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "bug", config.EntryType)
+	assert.Equal(t, "缺陷来源", config.Name)
+}
+
+func TestSettingService_UpdateCascadeCustomFieldOptions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/update_cascade_custom_field_options", r.URL.Path)
+
+		var req struct {
+			CustomField string           `json:"custom_field"`
+			Options     []*CascadeOption `json:"options"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "custom_field_102", req.CustomField)
+		require.Len(t, req.Options, 1)
+		assert.Equal(t, "广东", req.Options[0].Label)
+		require.Len(t, req.Options[0].Children, 1)
+		assert.Equal(t, "深圳", req.Options[0].Children[0].Label)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_cascade_custom_field_options.json"))
+	}))
+
+	config, _, err := client.SettingService.UpdateCascadeCustomFieldOptions(ctx, &UpdateCascadeCustomFieldOptionsRequest{
+		WorkspaceID: new(11112222),
+		EntryType:   new(EntityTypeStory),
+		CustomField: new("custom_field_102"),
+		Options: []*CascadeOption{
+			{Label: "广东", Children: []*CascadeOption{{Label: "深圳"}}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cascade_radio", config.Type)
+}
+
+func TestSettingService_CopyStoryWorkitemType(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/copy_story_workitem_type", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11113333), req["workspace_id"])
+		assert.Equal(t, float64(11112222), req["src_workspace_id"])
+		assert.Equal(t, float64(1111112222001000103), req["workitem_type_id"])
+		assert.Equal(t, "张三", req["creator"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/copy_story_workitem_type.json"))
+	}))
+
+	workitemType, _, err := client.SettingService.CopyStoryWorkitemType(ctx, &CopyStoryWorkitemTypeRequest{
+		WorkspaceID:    new(11113333),
+		SrcWorkspaceID: new(11112222),
+		WorkitemTypeID: new(int64(1111112222001000103)),
+		Creator:        new("张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111133333001000201", workitemType.ID)
+	assert.Equal(t, "11113333", workitemType.WorkspaceID)
+	assert.Equal(t, "用户故事", workitemType.Name)
+}
+
+func TestSettingService_CopyBugConfig(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/settings/copy_bug_config", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(11113333), req["workspace_id"])
+		assert.Equal(t, float64(11112222), req["src_workspace_id"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/setting/copy_bug_config.json"))
+	}))
+
+	copied, _, err := client.SettingService.CopyBugConfig(ctx, &CopyBugConfigRequest{
+		WorkspaceID:    new(11113333),
+		SrcWorkspaceID: new(11112222),
+	})
+	require.NoError(t, err)
+	assert.True(t, copied)
+}
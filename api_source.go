@@ -116,6 +116,93 @@ type (
 		Bug   *Bug   `json:"Bug,omitempty"`   // 关联缺陷
 		Task  *Task  `json:"Task,omitempty"`  // 关联任务
 	}
+
+	RemoveCommitRelationRequest struct {
+		WorkspaceID *int        `json:"workspace_id,omitempty"` // [必须]项目ID
+		CommitID    *string     `json:"commit_id,omitempty"`    // [必须]提交ID
+		Type        *EntityType `json:"type,omitempty"`         // [必须]TAPD业务对象类型，story、bug、task
+		ObjectID    *int64      `json:"object_id,omitempty"`    // [必须]TAPD业务对象ID
+	}
+
+	// Branch 工作项和Git分支的关联关系
+	Branch struct {
+		ID          string       `json:"id,omitempty"`           // 关联记录ID
+		WorkspaceID StringNumber `json:"workspace_id,omitempty"` // 项目ID
+		Type        EntityType   `json:"type,omitempty"`         // 业务对象类型
+		ObjectID    string       `json:"object_id,omitempty"`    // 业务对象ID
+		RepoID      string       `json:"repo_id,omitempty"`      // 仓库ID
+		Repo        string       `json:"repo,omitempty"`         // 仓库名
+		Branch      string       `json:"branch,omitempty"`       // 分支名
+		BranchURL   string       `json:"branch_url,omitempty"`   // 分支链接
+		GitEnv      string       `json:"git_env,omitempty"`      // 信息来源
+		Creator     string       `json:"creator,omitempty"`      // 创建人
+		Created     string       `json:"created,omitempty"`      // 创建时间
+	}
+
+	CreateBranchRelationRequest struct {
+		WorkspaceID *int        `json:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *EntityType `json:"type,omitempty"`         // [必须]TAPD业务对象类型，story、bug、task
+		ObjectID    *int64      `json:"object_id,omitempty"`    // [必须]TAPD业务对象ID
+		RepoID      *string     `json:"repo_id,omitempty"`      // [必须]仓库ID
+		Repo        *string     `json:"repo,omitempty"`         // [必须]仓库名
+		Branch      *string     `json:"branch,omitempty"`       // [必须]分支名
+		BranchURL   *string     `json:"branch_url,omitempty"`   // 分支链接
+		GitEnv      *string     `json:"git_env,omitempty"`      // 信息来源，github、gitlab 等
+		Creator     *string     `json:"creator,omitempty"`      // 创建人
+	}
+
+	RemoveBranchRelationRequest struct {
+		WorkspaceID *int        `json:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *EntityType `json:"type,omitempty"`         // [必须]TAPD业务对象类型，story、bug、task
+		ObjectID    *int64      `json:"object_id,omitempty"`    // [必须]TAPD业务对象ID
+		RepoID      *string     `json:"repo_id,omitempty"`      // [必须]仓库ID
+		Branch      *string     `json:"branch,omitempty"`       // [必须]分支名
+	}
+
+	GetBranchRelationsRequest struct {
+		WorkspaceID *int        `url:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *EntityType `url:"type,omitempty"`         // [必须]TAPD业务对象类型，story、bug、task
+		ObjectID    *int64      `url:"object_id,omitempty"`    // [必须]TAPD业务对象ID
+		Limit       *int        `url:"limit,omitempty"`        // 返回数量限制，默认30，最大200
+		Page        *int        `url:"page,omitempty"`         // 当前页，默认1
+	}
+
+	GetBranchWorkItemsRequest struct {
+		WorkspaceID *int        `url:"workspace_id,omitempty"` // [必须]项目ID
+		RepoID      *string     `url:"repo_id,omitempty"`      // [必须]仓库ID
+		Branch      *string     `url:"branch,omitempty"`       // [必须]分支名
+		EntityType  *EntityType `url:"entity_type,omitempty"`  // 业务对象类型，story、bug、task
+		Limit       *int        `url:"limit,omitempty"`        // 返回数量限制，默认30，最大200
+		Page        *int        `url:"page,omitempty"`         // 当前页，默认1
+	}
+
+	// RepoLink 代码仓库与TAPD空间的关联关系
+	RepoLink struct {
+		ID          string       `json:"id,omitempty"`           // 关联记录ID
+		WorkspaceID StringNumber `json:"workspace_id,omitempty"` // 项目ID
+		RepoID      string       `json:"repo_id,omitempty"`      // 仓库ID
+		Repo        string       `json:"repo,omitempty"`         // 仓库名
+		RepoURL     string       `json:"repo_url,omitempty"`     // 仓库链接
+		GitEnv      string       `json:"git_env,omitempty"`      // 信息来源
+		Creator     string       `json:"creator,omitempty"`      // 创建人
+		Created     string       `json:"created,omitempty"`      // 创建时间
+	}
+
+	LinkRepoWorkspaceRequest struct {
+		WorkspaceID *int    `json:"workspace_id,omitempty"` // [必须]项目ID
+		RepoID      *string `json:"repo_id,omitempty"`      // [必须]仓库ID
+		Repo        *string `json:"repo,omitempty"`         // [必须]仓库名
+		RepoURL     *string `json:"repo_url,omitempty"`     // 仓库链接
+		GitEnv      *string `json:"git_env,omitempty"`      // 信息来源，github、gitlab 等
+		Creator     *string `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetRepoWorkspacesRequest struct {
+		RepoID *string `url:"repo_id,omitempty"` // [必须]仓库ID
+		GitEnv *string `url:"git_env,omitempty"` // 信息来源
+		Limit  *int    `url:"limit,omitempty"`   // 返回数量限制，默认30，最大200
+		Page   *int    `url:"page,omitempty"`    // 当前页，默认1
+	}
 )
 
 type SourceService interface {
@@ -139,6 +226,55 @@ type SourceService interface {
 	GetCommitObjects(
 		ctx context.Context, request *GetCommitObjectsRequest, opts ...RequestOption,
 	) ([]*CommitObject, *Response, error)
+
+	// RemoveCommitRelation 解除commit与工作项关联关系
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/remove_commit_relation.html
+	RemoveCommitRelation(
+		ctx context.Context, request *RemoveCommitRelationRequest, opts ...RequestOption,
+	) (bool, *Response, error)
+
+	// CreateBranchRelation 创建工作项和Git分支关联关系
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/create_branch_relation.html
+	CreateBranchRelation(
+		ctx context.Context, request *CreateBranchRelationRequest, opts ...RequestOption,
+	) (*Branch, *Response, error)
+
+	// RemoveBranchRelation 解除工作项和Git分支关联
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/remove_branch_relation.html
+	RemoveBranchRelation(
+		ctx context.Context, request *RemoveBranchRelationRequest, opts ...RequestOption,
+	) (bool, *Response, error)
+
+	// GetBranchRelations 获取工作项和Git分支的关联关系
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/get_branch_relations.html
+	GetBranchRelations(
+		ctx context.Context, request *GetBranchRelationsRequest, opts ...RequestOption,
+	) ([]*Branch, *Response, error)
+
+	// GetBranchWorkItems 获取分支关联工作项
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/get_branch_workitems.html
+	GetBranchWorkItems(
+		ctx context.Context, request *GetBranchWorkItemsRequest, opts ...RequestOption,
+	) ([]*CommitObject, *Response, error)
+
+	// LinkRepoWorkspace 关联代码仓库与TAPD空间
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/link_repo_workspace.html
+	LinkRepoWorkspace(
+		ctx context.Context, request *LinkRepoWorkspaceRequest, opts ...RequestOption,
+	) (*RepoLink, *Response, error)
+
+	// GetRepoWorkspaces 获取代码仓库与TAPD关联空间列表
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/source/get_repo_workspaces.html
+	GetRepoWorkspaces(
+		ctx context.Context, request *GetRepoWorkspacesRequest, opts ...RequestOption,
+	) ([]*RepoLink, *Response, error)
 }
 
 type sourceService struct {
@@ -203,3 +339,122 @@ func (s *sourceService) GetCommitObjects(
 
 	return objects, resp, nil
 }
+
+func (s *sourceService) RemoveCommitRelation(
+	ctx context.Context, request *RemoveCommitRelationRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_commit_infos/remove_relation", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *sourceService) CreateBranchRelation(
+	ctx context.Context, request *CreateBranchRelationRequest, opts ...RequestOption,
+) (*Branch, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_branches", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(Branch)
+	resp, err := s.client.Do(req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response, resp, nil
+}
+
+func (s *sourceService) RemoveBranchRelation(
+	ctx context.Context, request *RemoveBranchRelationRequest, opts ...RequestOption,
+) (bool, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_branches/remove_relation", request, opts)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var result bool
+	resp, err := s.client.Do(req, &result)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *sourceService) GetBranchRelations(
+	ctx context.Context, request *GetBranchRelationsRequest, opts ...RequestOption,
+) ([]*Branch, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_branches", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branches := make([]*Branch, 0)
+	resp, err := s.client.Do(req, &branches)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return branches, resp, nil
+}
+
+func (s *sourceService) GetBranchWorkItems(
+	ctx context.Context, request *GetBranchWorkItemsRequest, opts ...RequestOption,
+) ([]*CommitObject, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_branches/workitems", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objects := make([]*CommitObject, 0)
+	resp, err := s.client.Do(req, &objects)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return objects, resp, nil
+}
+
+func (s *sourceService) LinkRepoWorkspace(
+	ctx context.Context, request *LinkRepoWorkspaceRequest, opts ...RequestOption,
+) (*RepoLink, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "code_repos/link_workspace", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(RepoLink)
+	resp, err := s.client.Do(req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response, resp, nil
+}
+
+func (s *sourceService) GetRepoWorkspaces(
+	ctx context.Context, request *GetRepoWorkspacesRequest, opts ...RequestOption,
+) ([]*RepoLink, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "code_repos/workspaces", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	links := make([]*RepoLink, 0)
+	resp, err := s.client.Do(req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return links, resp, nil
+}
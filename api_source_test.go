@@ -107,3 +107,172 @@ func TestSourceService_GetCommitObjects(t *testing.T) {
 	assert.Equal(t, "666", objects[0].Task.Name)
 	assert.Equal(t, TaskStatusOpen, objects[0].Task.Status)
 }
+
+func TestSourceService_RemoveCommitRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_commit_infos/remove_relation", r.URL.Path)
+
+		var req RemoveCommitRelationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 20375571, *req.WorkspaceID)
+		assert.Equal(t, "zxxxxx", *req.CommitID)
+		assert.Equal(t, EntityTypeStory, *req.Type)
+		assert.Equal(t, int64(1020375571854927829), *req.ObjectID)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/remove_commit_relation.json"))
+	}))
+
+	ok, _, err := client.SourceService.RemoveCommitRelation(ctx, &RemoveCommitRelationRequest{
+		WorkspaceID: new(20375571),
+		CommitID:    new("zxxxxx"),
+		Type:        new(EntityTypeStory),
+		ObjectID:    new(int64(1020375571854927829)),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestSourceService_CreateBranchRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_branches", r.URL.Path)
+
+		var req CreateBranchRelationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 20375571, *req.WorkspaceID)
+		assert.Equal(t, EntityTypeStory, *req.Type)
+		assert.Equal(t, int64(1020375571854927829), *req.ObjectID)
+		assert.Equal(t, "repos/xxx_proj", *req.Repo)
+		assert.Equal(t, "feature/login", *req.Branch)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/create_branch_relation.json"))
+	}))
+
+	branch, _, err := client.SourceService.CreateBranchRelation(ctx, &CreateBranchRelationRequest{
+		WorkspaceID: new(20375571),
+		Type:        new(EntityTypeStory),
+		ObjectID:    new(int64(1020375571854927829)),
+		RepoID:      new("abcd1234-avcd-1234-avcd-1234abcdefgh"),
+		Repo:        new("repos/xxx_proj"),
+		Branch:      new("feature/login"),
+		GitEnv:      new("gitlab"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1020375571000000101", branch.ID)
+	assert.Equal(t, "20375571", branch.WorkspaceID.String())
+	assert.Equal(t, EntityTypeStory, branch.Type)
+	assert.Equal(t, "feature/login", branch.Branch)
+}
+
+func TestSourceService_RemoveBranchRelation(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_branches/remove_relation", r.URL.Path)
+
+		var req RemoveBranchRelationRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "feature/login", *req.Branch)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/remove_branch_relation.json"))
+	}))
+
+	ok, _, err := client.SourceService.RemoveBranchRelation(ctx, &RemoveBranchRelationRequest{
+		WorkspaceID: new(20375571),
+		Type:        new(EntityTypeStory),
+		ObjectID:    new(int64(1020375571854927829)),
+		RepoID:      new("abcd1234-avcd-1234-avcd-1234abcdefgh"),
+		Branch:      new("feature/login"),
+	})
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestSourceService_GetBranchRelations(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_branches", r.URL.Path)
+		assert.Equal(t, "20375571", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("type"))
+		assert.Equal(t, "1020375571854927829", r.URL.Query().Get("object_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/get_branch_relations.json"))
+	}))
+
+	branches, _, err := client.SourceService.GetBranchRelations(ctx, &GetBranchRelationsRequest{
+		WorkspaceID: new(20375571),
+		Type:        new(EntityTypeStory),
+		ObjectID:    new(int64(1020375571854927829)),
+	})
+	require.NoError(t, err)
+	require.Len(t, branches, 2)
+	assert.Equal(t, "feature/login", branches[0].Branch)
+	assert.Equal(t, "20375571", branches[1].WorkspaceID.String())
+	assert.Equal(t, "bugfix/login-timeout", branches[1].Branch)
+}
+
+func TestSourceService_GetBranchWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_branches/workitems", r.URL.Path)
+		assert.Equal(t, "abcd1234-avcd-1234-avcd-1234abcdefgh", r.URL.Query().Get("repo_id"))
+		assert.Equal(t, "feature/login", r.URL.Query().Get("branch"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/get_branch_workitems.json"))
+	}))
+
+	objects, _, err := client.SourceService.GetBranchWorkItems(ctx, &GetBranchWorkItemsRequest{
+		WorkspaceID: new(20375571),
+		RepoID:      new("abcd1234-avcd-1234-avcd-1234abcdefgh"),
+		Branch:      new("feature/login"),
+	})
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.NotNil(t, objects[0].Story)
+	assert.Equal(t, "登录功能", objects[0].Story.Name)
+	require.NotNil(t, objects[1].Bug)
+	assert.Equal(t, "登录超时", objects[1].Bug.Title)
+}
+
+func TestSourceService_LinkRepoWorkspace(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/code_repos/link_workspace", r.URL.Path)
+
+		var req LinkRepoWorkspaceRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 20375571, *req.WorkspaceID)
+		assert.Equal(t, "abcd1234-avcd-1234-avcd-1234abcdefgh", *req.RepoID)
+		assert.Equal(t, "https://git.example.com/repos/xxx_proj", *req.RepoURL)
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/link_repo_workspace.json"))
+	}))
+
+	link, _, err := client.SourceService.LinkRepoWorkspace(ctx, &LinkRepoWorkspaceRequest{
+		WorkspaceID: new(20375571),
+		RepoID:      new("abcd1234-avcd-1234-avcd-1234abcdefgh"),
+		Repo:        new("repos/xxx_proj"),
+		RepoURL:     new("https://git.example.com/repos/xxx_proj"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1020375571000000201", link.ID)
+	assert.Equal(t, "20375571", link.WorkspaceID.String())
+}
+
+func TestSourceService_GetRepoWorkspaces(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/code_repos/workspaces", r.URL.Path)
+		assert.Equal(t, "abcd1234-avcd-1234-avcd-1234abcdefgh", r.URL.Query().Get("repo_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/source/get_repo_workspaces.json"))
+	}))
+
+	links, _, err := client.SourceService.GetRepoWorkspaces(ctx, &GetRepoWorkspacesRequest{
+		RepoID: new("abcd1234-avcd-1234-avcd-1234abcdefgh"),
+	})
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	assert.Equal(t, "20375571", links[0].WorkspaceID.String())
+	assert.Equal(t, "20375572", links[1].WorkspaceID.String())
+}
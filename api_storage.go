@@ -0,0 +1,360 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+type (
+	// StorageRecord 公共存储数据
+	StorageRecord struct {
+		ID          string          `json:"id,omitempty"`           // 数据ID
+		WorkspaceID string          `json:"workspace_id,omitempty"` // 项目ID
+		AppID       string          `json:"app_id,omitempty"`       // 应用ID
+		Type        string          `json:"type,omitempty"`         // 数据类型，用于区分不同的数据集合
+		Data        json.RawMessage `json:"data,omitempty"`         // 数据内容，JSON 对象
+		Creator     string          `json:"creator,omitempty"`      // 创建人
+		Created     string          `json:"created,omitempty"`      // 创建时间
+		Modified    string          `json:"modified,omitempty"`     // 最后修改时间
+	}
+
+	SaveStorageDataRequest struct {
+		WorkspaceID *int            `json:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *string         `json:"type,omitempty"`         // [必须]数据类型
+		Data        json.RawMessage `json:"data,omitempty"`         // [必须]数据内容，JSON 对象
+		Creator     *string         `json:"creator,omitempty"`      // 创建人
+	}
+
+	GetStorageDataRequest struct {
+		WorkspaceID *int              `url:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *string           `url:"type,omitempty"`         // [必须]数据类型
+		Condition   *StorageCondition `url:"condition,omitempty"`    // 查询条件，见条件语法
+		Limit       *int              `url:"limit,omitempty"`        // 设置返回数量限制，默认为30
+		Page        *int              `url:"page,omitempty"`         // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order            `url:"order,omitempty"`        // 排序规则，规则：字段名 ASC或者DESC
+	}
+
+	UpdateStorageDataRequest struct {
+		WorkspaceID *int              `json:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *string           `json:"type,omitempty"`         // [必须]数据类型
+		Condition   *StorageCondition `json:"condition,omitempty"`    // [必须]更新条件，见条件语法
+		Data        json.RawMessage   `json:"data,omitempty"`         // [必须]新的数据内容，JSON 对象
+	}
+
+	DeleteStorageDataRequest struct {
+		WorkspaceID *int              `json:"workspace_id,omitempty"` // [必须]项目ID
+		Type        *string           `json:"type,omitempty"`         // [必须]数据类型
+		Condition   *StorageCondition `json:"condition,omitempty"`    // [必须]删除条件，见条件语法
+	}
+)
+
+// ErrStorageConditionRequired is returned when updating or deleting storage
+// data without a condition, which would affect every record of the type.
+var ErrStorageConditionRequired = errors.New("tapd: storage condition required")
+
+// StorageService 公共存储
+//
+// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/
+type StorageService interface {
+	// SaveStorageData 保存数据
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/save.html
+	SaveStorageData(ctx context.Context, request *SaveStorageDataRequest, opts ...RequestOption) (*StorageRecord, *Response, error)
+
+	// GetStorageData 查询数据
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/query.html
+	GetStorageData(ctx context.Context, request *GetStorageDataRequest, opts ...RequestOption) ([]*StorageRecord, *Response, error)
+
+	// UpdateStorageData 更新数据，返回更新的数据条数
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/update.html
+	UpdateStorageData(ctx context.Context, request *UpdateStorageDataRequest, opts ...RequestOption) (int, *Response, error)
+
+	// DeleteStorageData 删除数据，返回删除的数据条数
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/storage/delete.html
+	DeleteStorageData(ctx context.Context, request *DeleteStorageDataRequest, opts ...RequestOption) (int, *Response, error)
+}
+
+type storageService struct {
+	client *Client
+}
+
+var _ StorageService = (*storageService)(nil)
+
+func NewStorageService(client *Client) StorageService {
+	return &storageService{
+		client: client,
+	}
+}
+
+func (s *storageService) SaveStorageData(
+	ctx context.Context, request *SaveStorageDataRequest, opts ...RequestOption,
+) (*StorageRecord, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storage/save", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		Storage *StorageRecord `json:"Storage"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Storage, resp, nil
+}
+
+func (s *storageService) GetStorageData(
+	ctx context.Context, request *GetStorageDataRequest, opts ...RequestOption,
+) ([]*StorageRecord, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "storage/query", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Storage *StorageRecord `json:"Storage"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	records := make([]*StorageRecord, 0, len(items))
+	for _, item := range items {
+		records = append(records, item.Storage)
+	}
+
+	return records, resp, nil
+}
+
+func (s *storageService) UpdateStorageData(
+	ctx context.Context, request *UpdateStorageDataRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	if request == nil || request.Condition.String() == "" {
+		return 0, nil, ErrStorageConditionRequired
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storage/update", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+func (s *storageService) DeleteStorageData(
+	ctx context.Context, request *DeleteStorageDataRequest, opts ...RequestOption,
+) (int, *Response, error) {
+	if request == nil || request.Condition.String() == "" {
+		return 0, nil, ErrStorageConditionRequired
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, "storage/delete", request, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var response CountResponse
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return response.Count, resp, nil
+}
+
+// Unmarshal decodes the data into v. Data returned as a JSON encoded string is
+// decoded as well.
+func (r *StorageRecord) Unmarshal(v any) error {
+	data := r.Data
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(s)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// -----------------------------------------------------------------------------
+// StorageCondition is a condition written in the public storage condition
+// syntax, built from fields and combined with StorageAnd, StorageOr and
+// StorageNot.
+//
+// Example:
+//
+//	StorageAnd(
+//		StorageField("status").Eq("open"),
+//		StorageField("count").Gte(3),
+//	) => status = "open" AND count >= 3
+// -----------------------------------------------------------------------------
+
+type StorageCondition struct {
+	expr     string
+	compound bool // needs parentheses when nested
+}
+
+var (
+	_ query.Encoder  = (*StorageCondition)(nil)
+	_ json.Marshaler = (*StorageCondition)(nil)
+)
+
+func (c *StorageCondition) EncodeValues(key string, v *url.Values) error {
+	if c != nil && c.expr != "" {
+		v.Add(key, c.expr)
+	}
+	return nil
+}
+
+func (c *StorageCondition) String() string {
+	if c == nil {
+		return ""
+	}
+	return c.expr
+}
+
+func (c *StorageCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// StorageField is a data field used in storage conditions.
+type StorageField string
+
+// Eq matches values equal to v.
+func (f StorageField) Eq(v any) *StorageCondition {
+	return f.compare("=", v)
+}
+
+// Ne matches values not equal to v.
+func (f StorageField) Ne(v any) *StorageCondition {
+	return f.compare("!=", v)
+}
+
+// Gt matches values greater than v.
+func (f StorageField) Gt(v any) *StorageCondition {
+	return f.compare(">", v)
+}
+
+// Gte matches values greater than or equal to v.
+func (f StorageField) Gte(v any) *StorageCondition {
+	return f.compare(">=", v)
+}
+
+// Lt matches values less than v.
+func (f StorageField) Lt(v any) *StorageCondition {
+	return f.compare("<", v)
+}
+
+// Lte matches values less than or equal to v.
+func (f StorageField) Lte(v any) *StorageCondition {
+	return f.compare("<=", v)
+}
+
+// Like matches values against the pattern, where % matches any characters.
+func (f StorageField) Like(pattern string) *StorageCondition {
+	return f.compare("LIKE", pattern)
+}
+
+// In matches values equal to any of values.
+func (f StorageField) In(values ...any) *StorageCondition {
+	return f.list("IN", values)
+}
+
+// NotIn matches values equal to none of values.
+func (f StorageField) NotIn(values ...any) *StorageCondition {
+	return f.list("NOT IN", values)
+}
+
+func (f StorageField) compare(op string, v any) *StorageCondition {
+	return &StorageCondition{expr: string(f) + " " + op + " " + formatStorageValue(v)}
+}
+
+func (f StorageField) list(op string, values []any) *StorageCondition {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, formatStorageValue(value))
+	}
+	return &StorageCondition{expr: string(f) + " " + op + " (" + strings.Join(formatted, ", ") + ")"}
+}
+
+// StorageAnd matches when all conditions match. Nil conditions are skipped.
+func StorageAnd(conditions ...*StorageCondition) *StorageCondition {
+	return joinStorageConditions("AND", conditions)
+}
+
+// StorageOr matches when any condition matches. Nil conditions are skipped.
+func StorageOr(conditions ...*StorageCondition) *StorageCondition {
+	return joinStorageConditions("OR", conditions)
+}
+
+// StorageNot negates the condition.
+func StorageNot(condition *StorageCondition) *StorageCondition {
+	if condition == nil || condition.expr == "" {
+		return nil
+	}
+	return &StorageCondition{expr: "NOT (" + condition.expr + ")"}
+}
+
+func joinStorageConditions(op string, conditions []*StorageCondition) *StorageCondition {
+	valid := make([]*StorageCondition, 0, len(conditions))
+	for _, condition := range conditions {
+		if condition != nil && condition.expr != "" {
+			valid = append(valid, condition)
+		}
+	}
+
+	switch len(valid) {
+	case 0:
+		return nil
+	case 1:
+		return valid[0]
+	}
+
+	exprs := make([]string, 0, len(valid))
+	for _, condition := range valid {
+		if condition.compound {
+			exprs = append(exprs, "("+condition.expr+")")
+		} else {
+			exprs = append(exprs, condition.expr)
+		}
+	}
+	return &StorageCondition{expr: strings.Join(exprs, " "+op+" "), compound: true}
+}
+
+// formatStorageValue formats strings and times as double quoted literals and
+// other values as is.
+func formatStorageValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case time.Time:
+		return strconv.Quote(v.Format(time.DateTime))
+	case fmt.Stringer:
+		return strconv.Quote(v.String())
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
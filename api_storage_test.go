@@ -0,0 +1,184 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageService_SaveStorageData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storage/save", r.URL.Path)
+
+		var req struct {
+			WorkspaceID int             `json:"workspace_id"`
+			Type        string          `json:"type"`
+			Data        json.RawMessage `json:"data"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, 11112222, req.WorkspaceID)
+		assert.Equal(t, "sync_state", req.Type)
+		assert.JSONEq(t, `{"cursor":"abc","count":3}`, string(req.Data))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/save_storage_data.json"))
+	}))
+
+	record, _, err := client.StorageService.SaveStorageData(ctx, &SaveStorageDataRequest{
+		WorkspaceID: new(11112222),
+		Type:        new("sync_state"),
+		Data:        json.RawMessage(`{"cursor":"abc","count":3}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000501", record.ID)
+	assert.Equal(t, "sync_state", record.Type)
+	assert.JSONEq(t, `{"cursor":"abc","count":3}`, string(record.Data))
+}
+
+func TestStorageService_GetStorageData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/storage/query", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "sync_state", r.URL.Query().Get("type"))
+		assert.Equal(t, `count > 1`, r.URL.Query().Get("condition"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/get_storage_data.json"))
+	}))
+
+	records, _, err := client.StorageService.GetStorageData(ctx, &GetStorageDataRequest{
+		WorkspaceID: new(11112222),
+		Type:        new("sync_state"),
+		Condition:   StorageField("count").Gt(1),
+		Limit:       new(10),
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "1111112222001000502", records[1].ID)
+
+	var value struct {
+		Cursor string `json:"cursor"`
+		Count  int    `json:"count"`
+	}
+	require.NoError(t, records[1].Unmarshal(&value))
+	assert.Equal(t, "def", value.Cursor)
+	assert.Equal(t, 5, value.Count)
+}
+
+func TestStorageService_UpdateStorageData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storage/update", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "sync_state", req["type"])
+		assert.Equal(t, `id = "1111112222001000501"`, req["condition"])
+		assert.Equal(t, map[string]any{"cursor": "xyz"}, req["data"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/update_storage_data.json"))
+	}))
+
+	count, _, err := client.StorageService.UpdateStorageData(ctx, &UpdateStorageDataRequest{
+		WorkspaceID: new(11112222),
+		Type:        new("sync_state"),
+		Condition:   StorageField("id").Eq("1111112222001000501"),
+		Data:        json.RawMessage(`{"cursor":"xyz"}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestStorageService_DeleteStorageData(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/storage/delete", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, `cursor IN ("abc", "def")`, req["condition"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/storage/delete_storage_data.json"))
+	}))
+
+	count, _, err := client.StorageService.DeleteStorageData(ctx, &DeleteStorageDataRequest{
+		WorkspaceID: new(11112222),
+		Type:        new("sync_state"),
+		Condition:   StorageField("cursor").In("abc", "def"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStorageService_ConditionRequired(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}))
+
+	tests := []struct {
+		name      string
+		condition *StorageCondition
+	}{
+		{"nil", nil},
+		{"empty and", StorageAnd()},
+		{"nil or", StorageOr(nil, nil)},
+		{"nil not", StorageNot(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.StorageService.UpdateStorageData(ctx, &UpdateStorageDataRequest{
+				WorkspaceID: new(11112222),
+				Type:        new("sync_state"),
+				Condition:   tt.condition,
+				Data:        json.RawMessage(`{"cursor":"xyz"}`),
+			})
+			assert.ErrorIs(t, err, ErrStorageConditionRequired)
+
+			_, _, err = client.StorageService.DeleteStorageData(ctx, &DeleteStorageDataRequest{
+				WorkspaceID: new(11112222),
+				Type:        new("sync_state"),
+				Condition:   tt.condition,
+			})
+			assert.ErrorIs(t, err, ErrStorageConditionRequired)
+		})
+	}
+}
+
+func TestStorageCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition *StorageCondition
+		want      string
+	}{
+		{"eq string", StorageField("name").Eq(`a "b"`), `name = "a \"b\""`},
+		{"ne number", StorageField("count").Ne(3), `count != 3`},
+		{"gte", StorageField("count").Gte(3), `count >= 3`},
+		{"lt time", StorageField("created").Lt(time.Date(2025, 1, 2, 3, 4, 5, 0, time.Local)), `created < "2025-01-02 03:04:05"`},
+		{"like", StorageField("name").Like("%abc%"), `name LIKE "%abc%"`},
+		{"not in", StorageField("id").NotIn(1, 2), `id NOT IN (1, 2)`},
+		{"and", StorageAnd(StorageField("a").Eq(1), nil, StorageField("b").Eq(true)), `a = 1 AND b = true`},
+		{"single", StorageAnd(StorageNot(StorageField("a").Eq(1))), `NOT (a = 1)`},
+		{
+			"nested",
+			StorageOr(StorageAnd(StorageField("a").Eq(1), StorageField("b").Eq(2)), StorageField("c").Eq(nil)),
+			`(a = 1 AND b = 2) OR c = null`,
+		},
+		{"empty", StorageAnd(), ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.condition.String())
+		})
+	}
+
+	values := url.Values{}
+	require.NoError(t, StorageField("a").Eq(1).EncodeValues("condition", &values))
+	require.NoError(t, StorageAnd().EncodeValues("empty", &values))
+	assert.Equal(t, "condition=a+%3D+1", values.Encode())
+}
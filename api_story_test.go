@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -942,6 +943,9 @@ func TestStoryService_GetStoriesByViewConfID(t *testing.T) {
 		assert.Equal(t, "1111112222001000001", r.URL.Query().Get("view_conf_id"))
 		assert.Equal(t, "xinweihe", r.URL.Query().Get("current_user"))
 		assert.Equal(t, "planning", r.URL.Query().Get("status"))
+		assert.Equal(t, "2024-01-01~2024-02-01", r.URL.Query().Get("modified"))
+		assert.Equal(t, "<2024-03-01", r.URL.Query().Get("due"))
+		assert.False(t, r.URL.Query().Has("id"))
 		assert.Equal(t, "20", r.URL.Query().Get("limit"))
 		assert.Equal(t, "1", r.URL.Query().Get("page"))
 		assert.Equal(t, "id,name,status", r.URL.Query().Get("fields"))
@@ -953,8 +957,11 @@ func TestStoryService_GetStoriesByViewConfID(t *testing.T) {
 		ViewConfID:  new(int64(1111112222001000001)),
 		CurrentUser: new("xinweihe"),
 		GetStoriesRequest: GetStoriesRequest{
+			ID:          (*Multi[int64])(nil),
 			WorkspaceID: new(11112222),
 			Status:      NewEnum(StoryStatusPlanning),
+			Modified:    Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)),
+			Due:         Lt(time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)),
 			Limit:       new(20),
 			Page:        new(1),
 			Fields:      NewMulti("id", "name", "status"),
@@ -2,6 +2,7 @@ package tapd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -123,6 +124,7 @@ type (
 		CustomPlanField9  string        `json:"custom_plan_field_9,omitempty"`
 		CustomPlanField10 string        `json:"custom_plan_field_10,omitempty"`
 		PriorityLabel     PriorityLabel `json:"priority_label,omitempty"` // 优先级
+		CustomFields      CustomFields  `json:"-"`                        // 自定义字段，按字段标识（如 custom_field_17）索引
 	}
 
 	CreateTaskRequest struct {
@@ -198,6 +200,7 @@ type (
 		CustomField48    *string           `json:"custom_field_48,omitempty"`
 		CustomField49    *string           `json:"custom_field_49,omitempty"`
 		CustomField50    *string           `json:"custom_field_50,omitempty"`
+		CustomFields     CustomFields      `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）设置，与同名字段同时设置时以同名字段为准
 	}
 
 	UpdateTaskRequest struct {
@@ -280,6 +283,7 @@ type (
 		CustomPlanField8   *string        `json:"custom_plan_field_8,omitempty"`
 		CustomPlanField9   *string        `json:"custom_plan_field_9,omitempty"`
 		CustomPlanField10  *string        `json:"custom_plan_field_10,omitempty"`
+		CustomFields       CustomFields   `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）设置，与同名字段同时设置时以同名字段为准
 	}
 
 	GetTaskCustomFieldsSettingsRequest struct {
@@ -392,83 +396,90 @@ type (
 	}
 
 	GetTasksRequest struct {
-		ID               *Multi[int64]     `url:"id,omitempty"`               // 支持多ID查询、模糊匹配
-		Name             *string           `url:"name,omitempty"`             // 任务标题	支持模糊匹配
-		Description      *string           `url:"description,omitempty"`      // 任务详细描述
-		WorkspaceID      *int              `url:"workspace_id,omitempty"`     // [必须]项目ID
-		Creator          *string           `url:"creator,omitempty"`          // 创建人	支持多人员查询
-		Created          *string           `url:"created,omitempty"`          // 创建时间	支持时间查询
-		Modified         *string           `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
-		Status           *Enum[TaskStatus] `url:"status,omitempty"`           // 状态	支持枚举查询
-		Label            *Enum[string]     `url:"label,omitempty"`            // 标签查询	支持枚举查询
-		Owner            *string           `url:"owner,omitempty"`            // 任务当前处理人	支持模糊匹配
-		CC               *string           `url:"cc,omitempty"`               // 抄送人
-		Begin            *string           `url:"begin,omitempty"`            // 预计开始	支持时间查询
-		Due              *string           `url:"due,omitempty"`              // 预计结束	支持时间查询
-		StoryID          *Multi[int64]     `url:"story_id,omitempty"`         // 关联需求的ID	支持多ID查询
-		IterationID      *Enum[int64]      `url:"iteration_id,omitempty"`     // 所属迭代的ID	支持枚举查询
-		Priority         *string           `url:"priority,omitempty"`         //nolint:lll // 优先级。为了兼容自定义优先级，请使用 priority_label 字段，详情参考：如何兼容自定义优先级
-		PriorityLabel    *PriorityLabel    `url:"priority_label,omitempty"`   // 优先级。推荐使用这个字段
-		Progress         *int              `url:"progress,omitempty"`         // 进度
-		Completed        *string           `url:"completed,omitempty"`        // 完成时间	支持时间查询
-		EffortCompleted  *string           `url:"effort_completed,omitempty"` // 完成工时
-		Exceed           *float64          `url:"exceed,omitempty"`           // 超出工时
-		Remain           *float64          `url:"remain,omitempty"`           // 剩余工时
-		Effort           *string           `url:"effort,omitempty"`           // 预估工时
-		CustomFieldOne   *string           `url:"custom_field_one,omitempty"`
-		CustomFieldTwo   *string           `url:"custom_field_two,omitempty"`
-		CustomFieldThree *string           `url:"custom_field_three,omitempty"`
-		CustomFieldFour  *string           `url:"custom_field_four,omitempty"`
-		CustomFieldFive  *string           `url:"custom_field_five,omitempty"`
-		CustomFieldSix   *string           `url:"custom_field_six,omitempty"`
-		CustomFieldSeven *string           `url:"custom_field_seven,omitempty"`
-		CustomFieldEight *string           `url:"custom_field_eight,omitempty"`
-		CustomField9     *string           `url:"custom_field_9,omitempty"`
-		CustomField10    *string           `url:"custom_field_10,omitempty"`
-		CustomField11    *string           `url:"custom_field_11,omitempty"`
-		CustomField12    *string           `url:"custom_field_12,omitempty"`
-		CustomField13    *string           `url:"custom_field_13,omitempty"`
-		CustomField14    *string           `url:"custom_field_14,omitempty"`
-		CustomField15    *string           `url:"custom_field_15,omitempty"`
-		CustomField16    *string           `url:"custom_field_16,omitempty"`
-		CustomField17    *string           `url:"custom_field_17,omitempty"`
-		CustomField18    *string           `url:"custom_field_18,omitempty"`
-		CustomField19    *string           `url:"custom_field_19,omitempty"`
-		CustomField20    *string           `url:"custom_field_20,omitempty"`
-		CustomField21    *string           `url:"custom_field_21,omitempty"`
-		CustomField22    *string           `url:"custom_field_22,omitempty"`
-		CustomField23    *string           `url:"custom_field_23,omitempty"`
-		CustomField24    *string           `url:"custom_field_24,omitempty"`
-		CustomField25    *string           `url:"custom_field_25,omitempty"`
-		CustomField26    *string           `url:"custom_field_26,omitempty"`
-		CustomField27    *string           `url:"custom_field_27,omitempty"`
-		CustomField28    *string           `url:"custom_field_28,omitempty"`
-		CustomField29    *string           `url:"custom_field_29,omitempty"`
-		CustomField30    *string           `url:"custom_field_30,omitempty"`
-		CustomField31    *string           `url:"custom_field_31,omitempty"`
-		CustomField32    *string           `url:"custom_field_32,omitempty"`
-		CustomField33    *string           `url:"custom_field_33,omitempty"`
-		CustomField34    *string           `url:"custom_field_34,omitempty"`
-		CustomField35    *string           `url:"custom_field_35,omitempty"`
-		CustomField36    *string           `url:"custom_field_36,omitempty"`
-		CustomField37    *string           `url:"custom_field_37,omitempty"`
-		CustomField38    *string           `url:"custom_field_38,omitempty"`
-		CustomField39    *string           `url:"custom_field_39,omitempty"`
-		CustomField40    *string           `url:"custom_field_40,omitempty"`
-		CustomField41    *string           `url:"custom_field_41,omitempty"`
-		CustomField42    *string           `url:"custom_field_42,omitempty"`
-		CustomField43    *string           `url:"custom_field_43,omitempty"`
-		CustomField44    *string           `url:"custom_field_44,omitempty"`
-		CustomField45    *string           `url:"custom_field_45,omitempty"`
-		CustomField46    *string           `url:"custom_field_46,omitempty"`
-		CustomField47    *string           `url:"custom_field_47,omitempty"`
-		CustomField48    *string           `url:"custom_field_48,omitempty"`
-		CustomField49    *string           `url:"custom_field_49,omitempty"`
-		CustomField50    *string           `url:"custom_field_50,omitempty"`
-		Limit            *int              `url:"limit,omitempty"`  // 设置返回数量限制，默认为30
-		Page             *int              `url:"page,omitempty"`   // 返回当前数量限制下第N页的数据，默认为1（第一页）
-		Order            *Order            `url:"order,omitempty"`  //nolint:lll // 排序规则，规则：字段名 ASC或者DESC，然后 urlencode	如按创建时间逆序：order=created%20desc
-		Fields           *Multi[string]    `url:"fields,omitempty"` // 设置获取的字段，多个字段间以','逗号隔开
+		ID               Filter         `url:"id,omitempty"`               // 支持多ID查询、模糊匹配
+		Name             *string        `url:"name,omitempty"`             // 任务标题	支持模糊匹配
+		Description      *string        `url:"description,omitempty"`      // 任务详细描述
+		WorkspaceID      *int           `url:"workspace_id,omitempty"`     // [必须]项目ID
+		Creator          *string        `url:"creator,omitempty"`          // 创建人	支持多人员查询
+		Created          Filter         `url:"created,omitempty"`          // 创建时间	支持时间查询
+		Modified         Filter         `url:"modified,omitempty"`         // 最后修改时间	支持时间查询
+		Status           Filter         `url:"status,omitempty"`           // 状态	支持枚举查询
+		Label            *Enum[string]  `url:"label,omitempty"`            // 标签查询	支持枚举查询
+		Owner            *string        `url:"owner,omitempty"`            // 任务当前处理人	支持模糊匹配
+		CC               *string        `url:"cc,omitempty"`               // 抄送人
+		Begin            Filter         `url:"begin,omitempty"`            // 预计开始	支持时间查询
+		Due              Filter         `url:"due,omitempty"`              // 预计结束	支持时间查询
+		StoryID          *Multi[int64]  `url:"story_id,omitempty"`         // 关联需求的ID	支持多ID查询
+		IterationID      *Enum[int64]   `url:"iteration_id,omitempty"`     // 所属迭代的ID	支持枚举查询
+		Priority         *string        `url:"priority,omitempty"`         //nolint:lll // 优先级。为了兼容自定义优先级，请使用 priority_label 字段，详情参考：如何兼容自定义优先级
+		PriorityLabel    *PriorityLabel `url:"priority_label,omitempty"`   // 优先级。推荐使用这个字段
+		Progress         *int           `url:"progress,omitempty"`         // 进度
+		Completed        Filter         `url:"completed,omitempty"`        // 完成时间	支持时间查询
+		EffortCompleted  *string        `url:"effort_completed,omitempty"` // 完成工时
+		Exceed           *float64       `url:"exceed,omitempty"`           // 超出工时
+		Remain           *float64       `url:"remain,omitempty"`           // 剩余工时
+		Effort           *string        `url:"effort,omitempty"`           // 预估工时
+		CustomFieldOne   *string        `url:"custom_field_one,omitempty"`
+		CustomFieldTwo   *string        `url:"custom_field_two,omitempty"`
+		CustomFieldThree *string        `url:"custom_field_three,omitempty"`
+		CustomFieldFour  *string        `url:"custom_field_four,omitempty"`
+		CustomFieldFive  *string        `url:"custom_field_five,omitempty"`
+		CustomFieldSix   *string        `url:"custom_field_six,omitempty"`
+		CustomFieldSeven *string        `url:"custom_field_seven,omitempty"`
+		CustomFieldEight *string        `url:"custom_field_eight,omitempty"`
+		CustomField9     *string        `url:"custom_field_9,omitempty"`
+		CustomField10    *string        `url:"custom_field_10,omitempty"`
+		CustomField11    *string        `url:"custom_field_11,omitempty"`
+		CustomField12    *string        `url:"custom_field_12,omitempty"`
+		CustomField13    *string        `url:"custom_field_13,omitempty"`
+		CustomField14    *string        `url:"custom_field_14,omitempty"`
+		CustomField15    *string        `url:"custom_field_15,omitempty"`
+		CustomField16    *string        `url:"custom_field_16,omitempty"`
+		CustomField17    *string        `url:"custom_field_17,omitempty"`
+		CustomField18    *string        `url:"custom_field_18,omitempty"`
+		CustomField19    *string        `url:"custom_field_19,omitempty"`
+		CustomField20    *string        `url:"custom_field_20,omitempty"`
+		CustomField21    *string        `url:"custom_field_21,omitempty"`
+		CustomField22    *string        `url:"custom_field_22,omitempty"`
+		CustomField23    *string        `url:"custom_field_23,omitempty"`
+		CustomField24    *string        `url:"custom_field_24,omitempty"`
+		CustomField25    *string        `url:"custom_field_25,omitempty"`
+		CustomField26    *string        `url:"custom_field_26,omitempty"`
+		CustomField27    *string        `url:"custom_field_27,omitempty"`
+		CustomField28    *string        `url:"custom_field_28,omitempty"`
+		CustomField29    *string        `url:"custom_field_29,omitempty"`
+		CustomField30    *string        `url:"custom_field_30,omitempty"`
+		CustomField31    *string        `url:"custom_field_31,omitempty"`
+		CustomField32    *string        `url:"custom_field_32,omitempty"`
+		CustomField33    *string        `url:"custom_field_33,omitempty"`
+		CustomField34    *string        `url:"custom_field_34,omitempty"`
+		CustomField35    *string        `url:"custom_field_35,omitempty"`
+		CustomField36    *string        `url:"custom_field_36,omitempty"`
+		CustomField37    *string        `url:"custom_field_37,omitempty"`
+		CustomField38    *string        `url:"custom_field_38,omitempty"`
+		CustomField39    *string        `url:"custom_field_39,omitempty"`
+		CustomField40    *string        `url:"custom_field_40,omitempty"`
+		CustomField41    *string        `url:"custom_field_41,omitempty"`
+		CustomField42    *string        `url:"custom_field_42,omitempty"`
+		CustomField43    *string        `url:"custom_field_43,omitempty"`
+		CustomField44    *string        `url:"custom_field_44,omitempty"`
+		CustomField45    *string        `url:"custom_field_45,omitempty"`
+		CustomField46    *string        `url:"custom_field_46,omitempty"`
+		CustomField47    *string        `url:"custom_field_47,omitempty"`
+		CustomField48    *string        `url:"custom_field_48,omitempty"`
+		CustomField49    *string        `url:"custom_field_49,omitempty"`
+		CustomField50    *string        `url:"custom_field_50,omitempty"`
+		Limit            *int           `url:"limit,omitempty"`  // 设置返回数量限制，默认为30
+		Page             *int           `url:"page,omitempty"`   // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order            *Order         `url:"order,omitempty"`  //nolint:lll // 排序规则，规则：字段名 ASC或者DESC，然后 urlencode	如按创建时间逆序：order=created%20desc
+		Fields           *Multi[string] `url:"fields,omitempty"` // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetTasksByViewConfIDRequest struct {
+		ViewConfID  *int64  `url:"view_conf_id,omitempty"` // [必须]视图ID
+		CurrentUser *string `url:"current_user,omitempty"` // 当前登录用户视图
+		QueryToken  *string `url:"query_token,omitempty"`  // 列表queryToken，限定返回的任务范围
+		GetTasksRequest
 	}
 
 	GetTasksCountRequest struct {
@@ -609,6 +620,11 @@ type TaskService interface {
 	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/task/get_tasks.html
 	GetTasks(ctx context.Context, request *GetTasksRequest, opts ...RequestOption) ([]*Task, *Response, error)
 
+	// GetTasksByViewConfID 获取视图对应的任务列表
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/task/get_tasks_by_view_conf_id.html
+	GetTasksByViewConfID(ctx context.Context, request *GetTasksByViewConfIDRequest, opts ...RequestOption) ([]*Task, *Response, error)
+
 	// GetTasksCount 获取任务数量
 	//
 	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/task/get_tasks_count.html
@@ -811,6 +827,32 @@ func (s *taskService) GetTasks(
 	return tasks, resp, nil
 }
 
+func (s *taskService) GetTasksByViewConfID(
+	ctx context.Context, request *GetTasksByViewConfIDRequest, opts ...RequestOption,
+) ([]*Task, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "tasks/get_tasks_by_view_conf_id", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Task *Task `json:"Task"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tasks := make([]*Task, 0, len(items))
+	for _, item := range items {
+		if item.Task != nil {
+			tasks = append(tasks, item.Task)
+		}
+	}
+
+	return tasks, resp, nil
+}
+
 func (s *taskService) GetTasksCount(
 	ctx context.Context, request *GetTasksCountRequest, opts ...RequestOption,
 ) (int, *Response, error) {
@@ -941,3 +983,33 @@ func (s *taskService) GetTaskFieldsInfo(
 
 	return fields, resp, nil
 }
+
+func (t *Task) UnmarshalJSON(data []byte) error {
+	type alias Task
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+
+	fields, err := ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	t.CustomFields = fields
+
+	return nil
+}
+
+func (r CreateTaskRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateTaskRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
+
+func (r UpdateTaskRequest) MarshalJSON() ([]byte, error) {
+	type alias UpdateTaskRequest
+	return marshalWithCustomFields(alias(r), r.CustomFields)
+}
+
+// Definition converts the setting to a custom field definition.
+func (s *TaskCustomFieldsSetting) Definition() *CustomFieldDefinition {
+	return newCustomFieldDefinition(s.CustomField, s.Name, s.Type, s.Options, s.Enabled)
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTaskService_CreateTask(t *testing.T) {
@@ -48,18 +50,71 @@ func TestTaskService_GetTasks(t *testing.T) {
 		assert.Equal(t, http.MethodGet, r.Method)
 		assert.Equal(t, "/tasks", r.URL.Path)
 
+		assert.Equal(t, "open|done", r.URL.Query().Get("status"))
+		assert.Equal(t, ">=2024-01-01", r.URL.Query().Get("created"))
+		assert.Equal(t, "2024-01-01~2024-02-01", r.URL.Query().Get("due"))
+		assert.False(t, r.URL.Query().Has("id"))
+
 		_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_tasks.json"))
 	}))
 
+	var ids *Multi[int64]
 	tasks, _, err := client.TaskService.GetTasks(ctx, &GetTasksRequest{
+		ID:          ids,
 		WorkspaceID: new(11112222),
 		Status:      NewEnum(TaskStatusOpen, TaskStatusDone),
+		Created:     Gte(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)),
+		Due:         Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local)),
 		Fields:      NewMulti("id", "workspace_id"),
 	})
 	assert.NoError(t, err)
 	assert.True(t, len(tasks) > 0)
 }
 
+func TestTaskService_GetTasksByViewConfID(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/tasks/get_tasks_by_view_conf_id", r.URL.Path)
+
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111122233301000003", r.URL.Query().Get("view_conf_id"))
+		assert.Equal(t, "xinweihe", r.URL.Query().Get("current_user"))
+		assert.Equal(t, "token123", r.URL.Query().Get("query_token"))
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_tasks_by_view_conf_id.json"))
+	}))
+
+	tasks, _, err := client.TaskService.GetTasksByViewConfID(ctx, &GetTasksByViewConfIDRequest{
+		ViewConfID:  new(int64(1111122233301000003)),
+		CurrentUser: new("xinweihe"),
+		QueryToken:  new("token123"),
+		GetTasksRequest: GetTasksRequest{
+			WorkspaceID: new(11112222),
+			Limit:       new(20),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "1111112222001048647", tasks[0].ID)
+	assert.Equal(t, "视图任务一", tasks[0].Name)
+	assert.Equal(t, "1111112222001029006", tasks[1].ID)
+	assert.Equal(t, "视图任务二", tasks[1].Name)
+}
+
+func TestTaskService_GetTasksByViewConfID_SkipsEmptyItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":1,"data":[{"Task":{"id":"1111112222001048647"}},{}],"info":"success"}`))
+	}))
+
+	tasks, _, err := client.TaskService.GetTasksByViewConfID(ctx, &GetTasksByViewConfIDRequest{
+		ViewConfID: new(int64(1111122233301000003)),
+	})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "1111112222001048647", tasks[0].ID)
+}
+
 func TestTaskService_GetTasksCount(t *testing.T) {
 	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
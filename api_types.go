@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -33,8 +34,10 @@ func NewMulti[T any](values ...T) *Multi[T] {
 	return (*Multi[T])(&values)
 }
 
-func (m Multi[T]) EncodeValues(key string, v *url.Values) error {
-	if len(m) > 0 {
+// EncodeValues adds the values to v, and nothing for a nil or empty Multi, so
+// a nil *Multi assigned to a Filter field is omitted like a nil field.
+func (m *Multi[T]) EncodeValues(key string, v *url.Values) error {
+	if m != nil && len(*m) > 0 {
 		v.Add(key, m.String())
 	}
 	return nil
@@ -80,27 +83,165 @@ func NewEnum[T any](values ...T) *Enum[T] {
 	return (*Enum[T])(&values)
 }
 
-func (e Enum[T]) EncodeValues(key string, v *url.Values) error {
+// EncodeValues adds the values to v, and nothing for a nil or empty Enum, so
+// a nil *Enum assigned to a Filter field is omitted like a nil field.
+func (e *Enum[T]) EncodeValues(key string, v *url.Values) error {
+	if e != nil && len(*e) > 0 {
+		v.Add(key, e.String())
+	}
+	return nil
+}
+
+func (e Enum[T]) String() string {
 	if len(e) > 0 {
 		values := make([]string, 0, len(e))
 		for _, value := range e {
 			values = append(values, fmt.Sprint(value))
 		}
-		v.Add(key, strings.Join(values, "|"))
+		return strings.Join(values, "|")
 	}
-	return nil
+	return ""
 }
 
 func (e Enum[T]) MarshalJSON() ([]byte, error) {
 	if len(e) == 0 {
 		return json.Marshal(nil)
 	}
+	return json.Marshal(e.String())
+}
+
+// -----------------------------------------------------------------------------
+// Filter is a query value written in the TAPD filter syntax.
+//
+//	Eq("a") => a
+//	Ne("a") => <>a
+//	Gt(1), Gte(1), Lt(1), Lte(1) => >1, >=1, <1, <=1
+//	Like("a") => LIKE<a>
+//	In("a", "b") => a|b
+//	NotIn("a", "b") => -a|b
+//	Range(1, 9) => 1~9
+//	Between(from, to) => 2024-01-01~2024-02-01
+//
+// Multi and Enum are filters as well, so fields typed as Filter accept them too.
+// -----------------------------------------------------------------------------
+
+type Filter interface {
+	query.Encoder
+	fmt.Stringer
+}
 
-	values := make([]string, 0, len(e))
-	for _, value := range e {
-		values = append(values, fmt.Sprint(value))
+type filter string
+
+var (
+	_ Filter         = filter("")
+	_ json.Marshaler = filter("")
+	_ Filter         = (*Multi[string])(nil)
+	_ Filter         = (*Enum[string])(nil)
+)
+
+// Raw creates a filter from a hand-written expression, such as ">2024-01-01".
+func Raw(expr string) Filter {
+	return filter(expr)
+}
+
+// Eq creates an equal filter.
+func Eq(value any) Filter {
+	return filter(formatFilterValue(value))
+}
+
+// Ne creates a not equal filter.
+func Ne(value any) Filter {
+	return filter("<>" + formatFilterValue(value))
+}
+
+// Gt creates a greater than filter.
+func Gt(value any) Filter {
+	return filter(">" + formatFilterValue(value))
+}
+
+// Gte creates a greater than or equal filter.
+func Gte(value any) Filter {
+	return filter(">=" + formatFilterValue(value))
+}
+
+// Lt creates a less than filter.
+func Lt(value any) Filter {
+	return filter("<" + formatFilterValue(value))
+}
+
+// Lte creates a less than or equal filter.
+func Lte(value any) Filter {
+	return filter("<=" + formatFilterValue(value))
+}
+
+// Like creates a fuzzy match filter.
+func Like(value string) Filter {
+	return filter("LIKE<" + value + ">")
+}
+
+// In creates a filter matching any of the values.
+func In[T any](values ...T) Filter {
+	return filter(Enum[T](values).String())
+}
+
+// NotIn creates a filter matching none of the values.
+func NotIn[T any](values ...T) Filter {
+	if len(values) == 0 {
+		return filter("")
 	}
-	return json.Marshal(strings.Join(values, "|"))
+	return filter("-" + Enum[T](values).String())
+}
+
+// Range creates a filter matching the values between from and to, inclusive.
+func Range[T any](from, to T) Filter {
+	return filter(formatFilterValue(from) + "~" + formatFilterValue(to))
+}
+
+// Between creates a filter matching the times between from and to, inclusive.
+//
+// Both times are formatted as "2006-01-02" when they are at midnight, and as
+// "2006-01-02 15:04:05" otherwise.
+func Between(from, to time.Time) Filter {
+	layout := time.DateTime
+	if isMidnight(from) && isMidnight(to) {
+		layout = time.DateOnly
+	}
+	return filter(from.Format(layout) + "~" + to.Format(layout))
+}
+
+func (f filter) EncodeValues(key string, v *url.Values) error {
+	if f != "" {
+		v.Add(key, string(f))
+	}
+	return nil
+}
+
+func (f filter) String() string {
+	return string(f)
+}
+
+func (f filter) MarshalJSON() ([]byte, error) {
+	if f == "" {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(string(f))
+}
+
+// formatFilterValue formats time.Time values like Between does, and everything
+// else with fmt.Sprint.
+func formatFilterValue(value any) string {
+	if t, ok := value.(time.Time); ok {
+		if isMidnight(t) {
+			return t.Format(time.DateOnly)
+		}
+		return t.Format(time.DateTime)
+	}
+	return fmt.Sprint(value)
+}
+
+func isMidnight(t time.Time) bool {
+	hour, minute, sec := t.Clock()
+	return hour == 0 && minute == 0 && sec == 0 && t.Nanosecond() == 0
 }
 
 // -----------------------------------------------------------------------------
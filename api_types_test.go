@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTypes_Multi(t *testing.T) {
@@ -57,6 +60,60 @@ func TestTypes_Enum(t *testing.T) {
 	assert.Equal(t, "a|b|c", values.Get("key4"))
 }
 
+func TestTypes_Filter(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	moment := time.Date(2024, 1, 1, 8, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"raw", Raw(">2024-01-01"), ">2024-01-01"},
+		{"eq", Eq(1), "1"},
+		{"ne", Ne("done"), "<>done"},
+		{"gt", Gt(day), ">2024-01-01"},
+		{"gte", Gte(moment), ">=2024-01-01 08:30:00"},
+		{"lt", Lt(10), "<10"},
+		{"lte", Lte(10), "<=10"},
+		{"like", Like("登录"), "LIKE<登录>"},
+		{"in", In(StoryStatusPlanning, StoryStatusDeveloping), "planning|developing"},
+		{"not in", NotIn("closed", "rejected"), "-closed|rejected"},
+		{"range", Range(1, 9), "1~9"},
+		{"between dates", Between(day, day.AddDate(0, 1, 0)), "2024-01-01~2024-02-01"},
+		{"between times", Between(day, moment), "2024-01-01 00:00:00~2024-01-01 08:30:00"},
+		{"multi", NewMulti[int64](1, 2), "1,2"},
+		{"enum", NewEnum("a", "b"), "a|b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := &url.Values{}
+			assert.NoError(t, tt.filter.EncodeValues("key", values))
+			assert.Equal(t, tt.want, values.Get("key"))
+			assert.Equal(t, tt.want, tt.filter.String())
+		})
+	}
+
+	values := &url.Values{}
+	assert.NoError(t, In[string]().EncodeValues("empty", values))
+	assert.NoError(t, NotIn[string]().EncodeValues("empty", values))
+	assert.False(t, values.Has("empty"))
+}
+
+func TestTypes_Filter_NilPointer(t *testing.T) {
+	var (
+		ids      *Multi[int64]
+		statuses *Enum[string]
+	)
+	values, err := query.Values(struct {
+		ID     Filter `url:"id,omitempty"`
+		Status Filter `url:"status,omitempty"`
+	}{ID: ids, Status: statuses})
+	require.NoError(t, err)
+	assert.Empty(t, values)
+}
+
 func TestTypes_Order(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -1,8 +1,12 @@
 package tapd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"maps"
 	"net/http"
+	"slices"
 )
 
 type (
@@ -15,6 +19,32 @@ type (
 	GetRolesRequest struct {
 		WorkspaceID *int `url:"workspace_id,omitempty"` // 项目 ID
 	}
+
+	// UserConfig 用户个人配置
+	UserConfig struct {
+		User               string `json:"user,omitempty"`                 // 用户昵称
+		Name               string `json:"name,omitempty"`                 // 用户姓名
+		Email              string `json:"email,omitempty"`                // 邮箱
+		Avatar             string `json:"avatar,omitempty"`               // 头像
+		Language           string `json:"language,omitempty"`             // 界面语言
+		Timezone           string `json:"timezone,omitempty"`             // 时区
+		DefaultWorkspaceID string `json:"default_workspace_id,omitempty"` // 默认项目ID
+	}
+
+	GetUserConfigRequest struct {
+		User *string `url:"user,omitempty"` // [必须]用户昵称
+	}
+
+	// ThirdPartyUserID 用户在三方系统映射的userId
+	ThirdPartyUserID struct {
+		User   string // 用户昵称
+		UserID string // 三方系统userId
+	}
+
+	GetThirdPartyUserIDsRequest struct {
+		User     *Multi[string] `url:"user,omitempty"`     // [必须]用户昵称，多个以逗号分隔
+		Platform *string        `url:"platform,omitempty"` // 三方系统，如 wework、dingtalk、feishu
+	}
 )
 
 type UserService interface {
@@ -22,6 +52,18 @@ type UserService interface {
 	//
 	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/user/get_roles.html
 	GetRoles(ctx context.Context, request *GetRolesRequest, opts ...RequestOption) ([]*UserRole, *Response, error)
+
+	// GetUserConfig 获取用户个人配置
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/user/get_user_config.html
+	GetUserConfig(ctx context.Context, request *GetUserConfigRequest, opts ...RequestOption) (*UserConfig, *Response, error)
+
+	// GetThirdPartyUserIDs 获取用户在三方系统映射的userId
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/user/get_third_party_user_id.html
+	GetThirdPartyUserIDs(
+		ctx context.Context, request *GetThirdPartyUserIDsRequest, opts ...RequestOption,
+	) ([]*ThirdPartyUserID, *Response, error)
 }
 
 type userService struct {
@@ -57,3 +99,76 @@ func (s *userService) GetRoles(
 
 	return roles, resp, nil
 }
+
+func (s *userService) GetUserConfig(
+	ctx context.Context, request *GetUserConfigRequest, opts ...RequestOption,
+) (*UserConfig, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "users/config", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var response struct {
+		UserConfig *UserConfig `json:"UserConfig"`
+	}
+	resp, err := s.client.Do(req, &response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.UserConfig, resp, nil
+}
+
+func (s *userService) GetThirdPartyUserIDs(
+	ctx context.Context, request *GetThirdPartyUserIDsRequest, opts ...RequestOption,
+) ([]*ThirdPartyUserID, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "users/third_party_user_ids", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items thirdPartyUserIDs
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// users in the order requested, then the unexpected ones sorted
+	ids := make([]*ThirdPartyUserID, 0, len(items))
+	if request != nil && request.User != nil {
+		for _, user := range *request.User {
+			if userID, ok := items[user]; ok {
+				ids = append(ids, &ThirdPartyUserID{User: user, UserID: userID})
+				delete(items, user)
+			}
+		}
+	}
+	for _, user := range slices.Sorted(maps.Keys(items)) {
+		ids = append(ids, &ThirdPartyUserID{User: user, UserID: items[user]})
+	}
+
+	return ids, resp, nil
+}
+
+// thirdPartyUserIDs maps user nicks to third-party user IDs. TAPD returns an
+// empty array instead of an object when no user is mapped.
+type thirdPartyUserIDs map[string]string
+
+func (m *thirdPartyUserIDs) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) || (len(data) > 0 && data[0] == '[') {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		*m = make(thirdPartyUserIDs)
+		return nil
+	}
+
+	var items map[string]string
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*m = items
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserService_GetRoles(t *testing.T) {
@@ -23,3 +24,74 @@ func TestUserService_GetRoles(t *testing.T) {
 	assert.True(t, len(roles) > 0)
 	assert.Contains(t, roles, &UserRole{"1000000000000000002", "Admin"})
 }
+
+func TestUserService_GetUserConfig(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/users/config", r.URL.Path)
+		assert.Equal(t, "张三", r.URL.Query().Get("user"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/user/get_user_config.json"))
+	}))
+
+	config, _, err := client.UserService.GetUserConfig(ctx, &GetUserConfigRequest{
+		User: new("张三"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "zhangsan@example.com", config.Email)
+	assert.Equal(t, "Asia/Shanghai", config.Timezone)
+	assert.Equal(t, "11112222", config.DefaultWorkspaceID)
+}
+
+func TestUserService_GetThirdPartyUserIDs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/users/third_party_user_ids", r.URL.Path)
+		assert.Equal(t, "张三,李四", r.URL.Query().Get("user"))
+		assert.Equal(t, "wework", r.URL.Query().Get("platform"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/user/get_third_party_user_ids.json"))
+	}))
+
+	ids, _, err := client.UserService.GetThirdPartyUserIDs(ctx, &GetThirdPartyUserIDsRequest{
+		User:     NewMulti("张三", "李四"),
+		Platform: new("wework"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*ThirdPartyUserID{
+		{User: "张三", UserID: "zhangsan"},
+		{User: "李四", UserID: "lisi"},
+	}, ids)
+}
+
+func TestUserService_GetThirdPartyUserIDs_Order(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":1,"data":{"王五":"wangwu","张三":"zhangsan","李四":"lisi"},"info":"success"}`))
+	}))
+
+	ids, _, err := client.UserService.GetThirdPartyUserIDs(ctx, &GetThirdPartyUserIDsRequest{
+		User: NewMulti("李四", "张三"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*ThirdPartyUserID{
+		{User: "李四", UserID: "lisi"},
+		{User: "张三", UserID: "zhangsan"},
+		{User: "王五", UserID: "wangwu"},
+	}, ids)
+}
+
+func TestUserService_GetThirdPartyUserIDs_Empty(t *testing.T) {
+	for _, data := range []string{"[]", "null"} {
+		t.Run(data, func(t *testing.T) {
+			_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"status":1,"data":` + data + `,"info":"success"}`))
+			}))
+
+			ids, _, err := client.UserService.GetThirdPartyUserIDs(ctx, &GetThirdPartyUserIDsRequest{
+				User: NewMulti("张三"),
+			})
+			require.NoError(t, err)
+			assert.Empty(t, ids)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package tapd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 )
 
@@ -21,21 +24,144 @@ type (
 		Alias string `json:"alias,omitempty"` // 状态别名
 		Name  string `json:"name,omitempty"`  // 状态名称
 	}
+
+	GetWorkflowStatusMapRequest struct {
+		WorkspaceID    *int    `url:"workspace_id,omitempty"`     // [必须]项目 ID
+		System         *string `url:"system,omitempty"`           // [必须]系统名。可选值：story（需求）、bug（缺陷）
+		WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，仅 system 为 story 时有效
+	}
+
+	GetWorkflowFirstStepsRequest struct {
+		WorkspaceID    *int    `url:"workspace_id,omitempty"`     // [必须]项目 ID
+		System         *string `url:"system,omitempty"`           // [必须]系统名。可选值：story（需求）、bug（缺陷）
+		WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，仅 system 为 story 时有效
+	}
+
+	GetWorkflowLastStepsRequest struct {
+		WorkspaceID    *int    `url:"workspace_id,omitempty"`     // [必须]项目 ID
+		System         *string `url:"system,omitempty"`           // [必须]系统名。可选值：story（需求）、bug（缺陷）
+		WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，仅 system 为 story 时有效
+	}
+
+	// WorkflowStatus 工作流状态
+	WorkflowStatus struct {
+		Alias string `json:"alias,omitempty"` // 状态别名（英文名）
+		Name  string `json:"name,omitempty"`  // 状态名称（中文名）
+	}
+
+	GetWorkflowTransitionsRequest struct {
+		WorkspaceID    *int    `url:"workspace_id,omitempty"`     // [必须]项目 ID
+		System         *string `url:"system,omitempty"`           // [必须]系统名。可选值：story（需求）、bug（缺陷）
+		WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID，仅 system 为 story 时有效
+	}
+
+	// WorkflowTransition 工作流流转规则，即从 From 状态流转到 To 状态的一条边
+	WorkflowTransition struct {
+		Name   string                     `json:"Name,omitempty"`         // 流转名称，如 planning-developing
+		From   string                     `json:"StepPrevious,omitempty"` // 流转前状态
+		To     string                     `json:"StepNext,omitempty"`     // 流转后状态
+		Fields []*WorkflowTransitionField `json:"Appendfield,omitempty"`  // 流转时需填写的字段
+		Roles  []string                   `json:"Roles,omitempty"`        // 允许执行流转的角色ID，为空表示不限制
+	}
+
+	// WorkflowTransitionField 流转时需填写的字段
+	WorkflowTransitionField struct {
+		DBModel    string `json:"DBModel,omitempty"`    // 所属业务对象，如 Story、Bug
+		FieldName  string `json:"FieldName,omitempty"`  // 字段名
+		FieldLabel string `json:"FieldLabel,omitempty"` // 字段中文名
+		NotNull    string `json:"Notnull,omitempty"`    // 是否必填，1 为必填
+		Sort       string `json:"Sort,omitempty"`       // 排序
+		Default    string `json:"Default,omitempty"`    // 默认值
+	}
+
+	GetWorkflowsRequest struct {
+		WorkspaceID *int    `url:"workspace_id,omitempty"` // [必须]项目 ID
+		System      *string `url:"system,omitempty"`       // 系统名。可选值：story（需求）、bug（缺陷）
+	}
+
+	// WorkflowInfo 工作流
+	WorkflowInfo struct {
+		ID             string `json:"id,omitempty"`               // 工作流ID
+		WorkspaceID    string `json:"workspace_id,omitempty"`     // 项目ID
+		Name           string `json:"name,omitempty"`             // 工作流名称
+		System         string `json:"system,omitempty"`           // 系统名
+		WorkitemTypeID string `json:"workitem_type_id,omitempty"` // 关联的需求类别ID
+		Description    string `json:"description,omitempty"`      // 描述
+		Creator        string `json:"creator,omitempty"`          // 创建人
+		Created        string `json:"created,omitempty"`          // 创建时间
+		Modifier       string `json:"modifier,omitempty"`         // 最后修改人
+		Modified       string `json:"modified,omitempty"`         // 最后修改时间
+	}
+
+	GetWorkflowParallelNodesRequest struct {
+		WorkspaceID    *int    `url:"workspace_id,omitempty"`     // [必须]项目 ID
+		System         *string `url:"system,omitempty"`           // [必须]系统名。目前只支持 story（需求的）
+		WorkitemTypeID *int64  `url:"workitem_type_id,omitempty"` // 需求类别ID
+	}
+
+	// WorkflowParallelNode 并行工作节点及其包含的状态
+	WorkflowParallelNode struct {
+		ID       string   `json:"id,omitempty"`     // 节点ID
+		Name     string   `json:"name,omitempty"`   // 节点名称
+		Statuses []string `json:"status,omitempty"` // 节点包含的状态别名
+	}
 )
 
+// Required reports whether the field must be filled in for the transition.
+func (f *WorkflowTransitionField) Required() bool {
+	return f.NotNull == "1"
+}
+
+// RequiredFields returns the names of the fields that must be filled in for
+// the transition.
+func (t *WorkflowTransition) RequiredFields() []string {
+	fields := make([]string, 0, len(t.Fields))
+	for _, field := range t.Fields {
+		if field.Required() {
+			fields = append(fields, field.FieldName)
+		}
+	}
+	return fields
+}
+
 // WorkflowService 工作流
 type WorkflowService interface {
-	// 获取工作流流转细则
-	// 获取工作流结束状态
+	// GetWorkflowTransitions 获取工作流流转细则
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_transitions.html
+	GetWorkflowTransitions(ctx context.Context, request *GetWorkflowTransitionsRequest, opts ...RequestOption) ([]*WorkflowTransition, *Response, error)
+
+	// GetWorkflowLastSteps 获取工作流结束状态
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_last_steps.html
+	GetWorkflowLastSteps(ctx context.Context, request *GetWorkflowLastStepsRequest, opts ...RequestOption) ([]*WorkflowStatus, *Response, error)
 
 	// GetAllLastSteps 获取所有结束状态
 	//
 	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_all_last_steps.html
 	GetAllLastSteps(ctx context.Context, request *GetAllLastStepsRequest, opts ...RequestOption) ([]*WorkflowAllLastStep, *Response, error)
 
-	// 获取工作流状态中英文名对应关系
-	// 获取工作流起始状态
-	// 获取项目下的工作流列表
+	// GetWorkflowStatusMap 获取工作流状态中英文名对应关系
+	//
+	// 返回结果保持接口返回的状态顺序。
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_status_map.html
+	GetWorkflowStatusMap(ctx context.Context, request *GetWorkflowStatusMapRequest, opts ...RequestOption) ([]*WorkflowStatus, *Response, error)
+
+	// GetWorkflowFirstSteps 获取工作流起始状态
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_first_steps.html
+	GetWorkflowFirstSteps(ctx context.Context, request *GetWorkflowFirstStepsRequest, opts ...RequestOption) ([]*WorkflowStatus, *Response, error)
+
+	// GetWorkflows 获取项目下的工作流列表
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_list.html
+	GetWorkflows(ctx context.Context, request *GetWorkflowsRequest, opts ...RequestOption) ([]*WorkflowInfo, *Response, error)
+
+	// GetWorkflowParallelNodes 获取并行工作节点和状态的对应关系
+	//
+	// https://open.tapd.cn/document/api-doc/API%E6%96%87%E6%A1%A3/api_reference/workflow/get_workflow_parallel_nodes.html
+	GetWorkflowParallelNodes(ctx context.Context, request *GetWorkflowParallelNodesRequest, opts ...RequestOption) ([]*WorkflowParallelNode, *Response, error)
 }
 
 type workflowService struct {
@@ -75,3 +201,146 @@ func (s *workflowService) GetAllLastSteps(
 
 	return steps, resp, nil
 }
+
+func (s *workflowService) GetWorkflowLastSteps(
+	ctx context.Context, request *GetWorkflowLastStepsRequest, opts ...RequestOption,
+) ([]*WorkflowStatus, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/last_steps", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses workflowStatuses
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+func (s *workflowService) GetWorkflowStatusMap(
+	ctx context.Context, request *GetWorkflowStatusMapRequest, opts ...RequestOption,
+) ([]*WorkflowStatus, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/status_map", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses workflowStatuses
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+func (s *workflowService) GetWorkflowFirstSteps(
+	ctx context.Context, request *GetWorkflowFirstStepsRequest, opts ...RequestOption,
+) ([]*WorkflowStatus, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/first_steps", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses workflowStatuses
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+func (s *workflowService) GetWorkflowTransitions(
+	ctx context.Context, request *GetWorkflowTransitionsRequest, opts ...RequestOption,
+) ([]*WorkflowTransition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/transitions", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transitions := make([]*WorkflowTransition, 0)
+	resp, err := s.client.Do(req, &transitions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return transitions, resp, nil
+}
+
+func (s *workflowService) GetWorkflows(
+	ctx context.Context, request *GetWorkflowsRequest, opts ...RequestOption,
+) ([]*WorkflowInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/workflow_list", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []struct {
+		Workflow *WorkflowInfo `json:"Workflow"`
+	}
+	resp, err := s.client.Do(req, &items)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	workflows := make([]*WorkflowInfo, 0, len(items))
+	for _, item := range items {
+		workflows = append(workflows, item.Workflow)
+	}
+
+	return workflows, resp, nil
+}
+
+func (s *workflowService) GetWorkflowParallelNodes(
+	ctx context.Context, request *GetWorkflowParallelNodesRequest, opts ...RequestOption,
+) ([]*WorkflowParallelNode, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "workflows/parallel_nodes", request, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodes := make([]*WorkflowParallelNode, 0)
+	resp, err := s.client.Do(req, &nodes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return nodes, resp, nil
+}
+
+// workflowStatuses decodes an {"alias": "name"} object into statuses, keeping
+// the order of the keys. An empty array is accepted as an empty object.
+type workflowStatuses []*WorkflowStatus
+
+func (w *workflowStatuses) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); ok && delim == '[' {
+		*w = make(workflowStatuses, 0)
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("tapd: unexpected workflow statuses %s", data)
+	}
+
+	statuses := make(workflowStatuses, 0)
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var name string
+		if err := dec.Decode(&name); err != nil {
+			return err
+		}
+		statuses = append(statuses, &WorkflowStatus{Alias: key.(string), Name: name})
+	}
+	*w = statuses
+	return nil
+}
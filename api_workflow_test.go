@@ -25,3 +25,161 @@ func TestWorkflowService_GetAllLastSteps(t *testing.T) {
 	assert.NoError(t, err)
 	require.Len(t, steps, 2)
 }
+
+func TestWorkflowService_GetWorkflowLastSteps(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/last_steps", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "bug", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_last_steps.json"))
+	}))
+
+	steps, _, err := client.WorkflowService.GetWorkflowLastSteps(ctx, &GetWorkflowLastStepsRequest{
+		WorkspaceID: new(11112222),
+		System:      new("bug"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowStatus{
+		{Alias: "closed", Name: "已关闭"},
+		{Alias: "rejected", Name: "已拒绝"},
+	}, steps)
+}
+
+func TestWorkflowService_GetWorkflowStatusMap(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/status_map", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+		assert.Equal(t, "1112222991001000013", r.URL.Query().Get("workitem_type_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_status_map.json"))
+	}))
+
+	statuses, _, err := client.WorkflowService.GetWorkflowStatusMap(ctx, &GetWorkflowStatusMapRequest{
+		WorkspaceID:    new(11112222),
+		System:         new("story"),
+		WorkitemTypeID: new(int64(1112222991001000013)),
+	})
+	require.NoError(t, err)
+	require.Len(t, statuses, 5)
+	assert.Equal(t, &WorkflowStatus{Alias: "planning", Name: "规划中"}, statuses[0])
+	assert.Equal(t, &WorkflowStatus{Alias: "status_2", Name: "已上线"}, statuses[1])
+	assert.Equal(t, &WorkflowStatus{Alias: "status_3", Name: "已验收"}, statuses[4])
+}
+
+func TestWorkflowService_GetWorkflowFirstSteps(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/first_steps", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_first_steps.json"))
+	}))
+
+	steps, _, err := client.WorkflowService.GetWorkflowFirstSteps(ctx, &GetWorkflowFirstStepsRequest{
+		WorkspaceID: new(11112222),
+		System:      new("story"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*WorkflowStatus{{Alias: "planning", Name: "规划中"}}, steps)
+}
+
+func TestWorkflowService_WorkflowStatuses_EmptyArray(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+	}))
+
+	steps, _, err := client.WorkflowService.GetWorkflowFirstSteps(ctx, &GetWorkflowFirstStepsRequest{
+		WorkspaceID: new(11112222),
+		System:      new("bug"),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+}
+
+func TestWorkflowService_GetWorkflowTransitions(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/transitions", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+		assert.Equal(t, "1112222991001000013", r.URL.Query().Get("workitem_type_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_transitions.json"))
+	}))
+
+	transitions, _, err := client.WorkflowService.GetWorkflowTransitions(ctx, &GetWorkflowTransitionsRequest{
+		WorkspaceID:    new(11112222),
+		System:         new("story"),
+		WorkitemTypeID: new(int64(1112222991001000013)),
+	})
+	require.NoError(t, err)
+	require.Len(t, transitions, 4)
+
+	transition := transitions[0]
+	assert.Equal(t, "planning-developing", transition.Name)
+	assert.Equal(t, "planning", transition.From)
+	assert.Equal(t, "developing", transition.To)
+	require.Len(t, transition.Fields, 2)
+	assert.Equal(t, "Story", transition.Fields[0].DBModel)
+	assert.Equal(t, "owner", transition.Fields[0].FieldName)
+	assert.Equal(t, "处理人", transition.Fields[0].FieldLabel)
+	assert.True(t, transition.Fields[0].Required())
+	assert.False(t, transition.Fields[1].Required())
+	assert.Equal(t, []string{"owner"}, transition.RequiredFields())
+	assert.Equal(t, []string{"1000000000000000002"}, transition.Roles)
+
+	assert.Empty(t, transitions[1].Fields)
+	assert.Empty(t, transitions[1].Roles)
+}
+
+func TestWorkflowService_GetWorkflows(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/workflow_list", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflows.json"))
+	}))
+
+	workflows, _, err := client.WorkflowService.GetWorkflows(ctx, &GetWorkflowsRequest{
+		WorkspaceID: new(11112222),
+	})
+	require.NoError(t, err)
+	require.Len(t, workflows, 2)
+	assert.Equal(t, "1112222991001000001", workflows[0].ID)
+	assert.Equal(t, "11112222", workflows[0].WorkspaceID)
+	assert.Equal(t, "默认需求工作流", workflows[0].Name)
+	assert.Equal(t, "story", workflows[0].System)
+	assert.Equal(t, "1112222991001000013", workflows[0].WorkitemTypeID)
+	assert.Equal(t, "张三", workflows[0].Creator)
+	assert.Equal(t, "2024-01-02 10:00:00", workflows[0].Created)
+	assert.Equal(t, "李四", workflows[0].Modifier)
+	assert.Equal(t, "2024-03-04 11:00:00", workflows[0].Modified)
+	assert.Equal(t, "bug", workflows[1].System)
+}
+
+func TestWorkflowService_GetWorkflowParallelNodes(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/workflows/parallel_nodes", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_parallel_nodes.json"))
+	}))
+
+	nodes, _, err := client.WorkflowService.GetWorkflowParallelNodes(ctx, &GetWorkflowParallelNodesRequest{
+		WorkspaceID: new(11112222),
+		System:      new("story"),
+	})
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "node_1", nodes[0].ID)
+	assert.Equal(t, "开发", nodes[0].Name)
+	assert.Equal(t, []string{"developing", "status_3"}, nodes[0].Statuses)
+}
@@ -0,0 +1,619 @@
+package tapd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// WorkItem is the common view of stories, bugs and tasks.
+//
+// Story, Bug and Task implement it, so cross-type tooling can work with a
+// single code path instead of switching on EntityType.
+type WorkItem interface {
+	GetEntityType() EntityType     // 业务对象类型
+	GetID() string                 // ID
+	GetWorkspaceID() string        // 项目ID
+	GetName() string               // 标题，缺陷为 title
+	GetOwner() string              // 处理人，缺陷为 current_owner
+	GetStatus() string             // 状态
+	GetIterationID() string        // 迭代ID
+	GetBegin() string              // 预计开始
+	GetDue() string                // 预计结束
+	GetEffort() string             // 预估工时
+	GetCreated() string            // 创建时间
+	GetModified() string           // 最后修改时间
+	GetCustomFields() CustomFields // 自定义字段
+}
+
+var (
+	_ WorkItem = (*Story)(nil)
+	_ WorkItem = (*Bug)(nil)
+	_ WorkItem = (*Task)(nil)
+)
+
+func (s *Story) GetEntityType() EntityType {
+	return EntityTypeStory
+}
+
+func (s *Story) GetID() string {
+	return s.ID
+}
+
+func (s *Story) GetWorkspaceID() string {
+	return s.WorkspaceID
+}
+
+func (s *Story) GetName() string {
+	return s.Name
+}
+
+func (s *Story) GetOwner() string {
+	return s.Owner
+}
+
+func (s *Story) GetStatus() string {
+	return string(s.Status)
+}
+
+func (s *Story) GetIterationID() string {
+	return s.IterationID
+}
+
+func (s *Story) GetBegin() string {
+	return deref(s.Begin)
+}
+
+func (s *Story) GetDue() string {
+	return deref(s.Due)
+}
+
+func (s *Story) GetEffort() string {
+	return deref(s.Effort)
+}
+
+func (s *Story) GetCreated() string {
+	return s.Created
+}
+
+func (s *Story) GetModified() string {
+	return s.Modified
+}
+
+func (s *Story) GetCustomFields() CustomFields {
+	return s.CustomFields
+}
+
+func (b *Bug) GetEntityType() EntityType {
+	return EntityTypeBug
+}
+
+func (b *Bug) GetID() string {
+	return b.ID
+}
+
+func (b *Bug) GetWorkspaceID() string {
+	return b.WorkspaceID
+}
+
+func (b *Bug) GetName() string {
+	return b.Title
+}
+
+func (b *Bug) GetOwner() string {
+	return b.CurrentOwner
+}
+
+func (b *Bug) GetStatus() string {
+	return b.Status
+}
+
+func (b *Bug) GetIterationID() string {
+	return b.IterationID
+}
+
+func (b *Bug) GetBegin() string {
+	return b.Begin
+}
+
+func (b *Bug) GetDue() string {
+	return b.Due
+}
+
+func (b *Bug) GetEffort() string {
+	return b.Effort
+}
+
+func (b *Bug) GetCreated() string {
+	return b.Created
+}
+
+func (b *Bug) GetModified() string {
+	return b.Modified
+}
+
+func (b *Bug) GetCustomFields() CustomFields {
+	return b.CustomFields
+}
+
+func (t *Task) GetEntityType() EntityType {
+	return EntityTypeTask
+}
+
+func (t *Task) GetID() string {
+	return t.ID
+}
+
+func (t *Task) GetWorkspaceID() string {
+	return t.WorkspaceID
+}
+
+func (t *Task) GetName() string {
+	return t.Name
+}
+
+func (t *Task) GetOwner() string {
+	return t.Owner
+}
+
+func (t *Task) GetStatus() string {
+	return string(t.Status)
+}
+
+func (t *Task) GetIterationID() string {
+	return t.IterationID
+}
+
+func (t *Task) GetBegin() string {
+	return t.Begin
+}
+
+func (t *Task) GetDue() string {
+	return t.Due
+}
+
+func (t *Task) GetEffort() string {
+	return t.Effort
+}
+
+func (t *Task) GetCreated() string {
+	return t.Created
+}
+
+func (t *Task) GetModified() string {
+	return t.Modified
+}
+
+func (t *Task) GetCustomFields() CustomFields {
+	return t.CustomFields
+}
+
+// WorkItem returns the related story, bug or task, or nil if none is set.
+func (o *CommitObject) WorkItem() WorkItem {
+	switch {
+	case o.Story != nil:
+		return o.Story
+	case o.Bug != nil:
+		return o.Bug
+	case o.Task != nil:
+		return o.Task
+	default:
+		return nil
+	}
+}
+
+// ErrWorkItemNotFound is returned when the requested work item does not exist.
+var ErrWorkItemNotFound = errors.New("tapd: work item not found")
+
+type (
+	GetWorkItemRequest struct {
+		EntityType  *EntityType    // [必须]业务对象类型，story、bug、task
+		WorkspaceID *int           // [必须]项目ID
+		ID          *int64         // [必须]ID
+		Fields      *Multi[string] // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	GetWorkItemsRequest struct {
+		EntityType  *EntityType    // [必须]业务对象类型，story、bug、task
+		WorkspaceID *int           // [必须]项目ID
+		ID          Filter         // ID	支持多ID查询
+		Name        *string        // 标题	支持模糊匹配
+		Owner       *string        // 处理人	支持模糊匹配
+		Status      Filter         // 状态	支持枚举查询
+		IterationID *int64         // 迭代ID
+		Created     Filter         // 创建时间	支持时间查询
+		Modified    Filter         // 最后修改时间	支持时间查询
+		Limit       *int           // 设置返回数量限制，默认为30
+		Page        *int           // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Order       *Order         // 排序规则，规则：字段名 ASC或者DESC
+		Fields      *Multi[string] // 设置获取的字段，多个字段间以','逗号隔开
+	}
+
+	UpdateWorkItemRequest struct {
+		EntityType   *EntityType  // [必须]业务对象类型，story、bug、task
+		WorkspaceID  *int         // [必须]项目ID
+		ID           *int64       // [必须]ID
+		CurrentUser  *string      // 变更人，缺陷不支持，会被忽略
+		Name         *string      // 标题
+		Owner        *string      // 处理人
+		Status       *string      // 状态
+		IterationID  *int64       // 迭代ID
+		Begin        *string      // 预计开始
+		Due          *string      // 预计结束
+		Effort       *string      // 预估工时，缺陷不支持
+		CustomFields CustomFields // 自定义字段，按字段标识（如 custom_field_17）设置
+	}
+
+	GetWorkItemsByViewRequest struct {
+		ViewURL     *string        // TAPD 视图页面链接，未设置的 EntityType、WorkspaceID、ViewConfID、QueryToken 从中解析
+		EntityType  *EntityType    // 业务对象类型，story、bug、task
+		WorkspaceID *int           // 项目ID
+		ViewConfID  *int64         // 视图ID
+		QueryToken  *string        // 列表queryToken，可由 GetConvertStoryIDsToQueryToken 等获取
+		CurrentUser *string        // 当前登录用户视图
+		Limit       *int           // 设置返回数量限制，默认为30
+		Page        *int           // 返回当前数量限制下第N页的数据，默认为1（第一页）
+		Fields      *Multi[string] // 设置获取的字段，多个字段间以','逗号隔开
+	}
+)
+
+// WorkItemService operates on stories, bugs and tasks through the WorkItem
+// interface, dispatching to StoryService, BugService or TaskService by EntityType.
+type WorkItemService interface {
+	// GetWorkItem 获取单个工作项，不存在时返回 ErrWorkItemNotFound
+	GetWorkItem(ctx context.Context, request *GetWorkItemRequest, opts ...RequestOption) (WorkItem, *Response, error)
+
+	// GetWorkItems 查询工作项列表
+	GetWorkItems(ctx context.Context, request *GetWorkItemsRequest, opts ...RequestOption) ([]WorkItem, *Response, error)
+
+	// UpdateWorkItem 更新工作项
+	UpdateWorkItem(ctx context.Context, request *UpdateWorkItemRequest, opts ...RequestOption) (WorkItem, *Response, error)
+
+	// GetWorkItemsByView 获取视图对应的工作项列表，视图可由页面链接或视图ID指定
+	GetWorkItemsByView(ctx context.Context, request *GetWorkItemsByViewRequest, opts ...RequestOption) ([]WorkItem, *Response, error)
+}
+
+type workItemService struct {
+	client *Client
+}
+
+var _ WorkItemService = (*workItemService)(nil)
+
+func NewWorkItemService(client *Client) WorkItemService {
+	return &workItemService{
+		client: client,
+	}
+}
+
+func (s *workItemService) GetWorkItem(
+	ctx context.Context, request *GetWorkItemRequest, opts ...RequestOption,
+) (WorkItem, *Response, error) {
+	if request.EntityType == nil {
+		return nil, nil, errors.New("tapd: entity type is required")
+	}
+	if request.ID == nil {
+		return nil, nil, errors.New("tapd: work item id is required")
+	}
+
+	items, resp, err := s.GetWorkItems(ctx, &GetWorkItemsRequest{
+		EntityType:  request.EntityType,
+		WorkspaceID: request.WorkspaceID,
+		ID:          Eq(deref(request.ID)),
+		Fields:      request.Fields,
+	}, opts...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if len(items) == 0 {
+		return nil, resp, ErrWorkItemNotFound
+	}
+
+	return items[0], resp, nil
+}
+
+func (s *workItemService) GetWorkItems(
+	ctx context.Context, request *GetWorkItemsRequest, opts ...RequestOption,
+) ([]WorkItem, *Response, error) {
+	switch entityType := deref(request.EntityType); entityType {
+	case EntityTypeStory:
+		r := &GetStoriesRequest{
+			WorkspaceID: request.WorkspaceID,
+			ID:          request.ID,
+			Name:        request.Name,
+			Owner:       request.Owner,
+			Status:      request.Status,
+			Created:     request.Created,
+			Modified:    request.Modified,
+			Limit:       request.Limit,
+			Page:        request.Page,
+			Order:       request.Order,
+			Fields:      request.Fields,
+		}
+		if request.IterationID != nil {
+			r.IterationID = new(strconv.FormatInt(*request.IterationID, 10))
+		}
+
+		stories, resp, err := s.client.StoryService.GetStories(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(stories), resp, nil
+	case EntityTypeBug:
+		r := &GetBugsRequest{
+			WorkspaceID:  request.WorkspaceID,
+			ID:           request.ID,
+			Title:        request.Name,
+			CurrentOwner: request.Owner,
+			Status:       request.Status,
+			Created:      request.Created,
+			Modified:     request.Modified,
+			Limit:        request.Limit,
+			Page:         request.Page,
+			Order:        request.Order,
+			Fields:       request.Fields,
+		}
+		if request.IterationID != nil {
+			r.IterationID = NewEnum(strconv.FormatInt(*request.IterationID, 10))
+		}
+
+		bugs, resp, err := s.client.BugService.GetBugs(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(bugs), resp, nil
+	case EntityTypeTask:
+		r := &GetTasksRequest{
+			WorkspaceID: request.WorkspaceID,
+			ID:          request.ID,
+			Name:        request.Name,
+			Owner:       request.Owner,
+			Status:      request.Status,
+			Created:     request.Created,
+			Modified:    request.Modified,
+			Limit:       request.Limit,
+			Page:        request.Page,
+			Order:       request.Order,
+			Fields:      request.Fields,
+		}
+		if request.IterationID != nil {
+			r.IterationID = NewEnum(*request.IterationID)
+		}
+
+		tasks, resp, err := s.client.TaskService.GetTasks(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(tasks), resp, nil
+	default:
+		return nil, nil, fmt.Errorf("tapd: work items of entity type [%s] not supported", entityType)
+	}
+}
+
+func (s *workItemService) UpdateWorkItem(
+	ctx context.Context, request *UpdateWorkItemRequest, opts ...RequestOption,
+) (WorkItem, *Response, error) {
+	switch entityType := deref(request.EntityType); entityType {
+	case EntityTypeStory:
+		r := &UpdateStoryRequest{
+			ID:           request.ID,
+			WorkspaceID:  request.WorkspaceID,
+			CurrentUser:  request.CurrentUser,
+			Name:         request.Name,
+			Owner:        request.Owner,
+			Status:       request.Status,
+			Begin:        request.Begin,
+			Due:          request.Due,
+			Effort:       request.Effort,
+			CustomFields: request.CustomFields,
+		}
+		if request.IterationID != nil {
+			r.IterationID = new(strconv.FormatInt(*request.IterationID, 10))
+		}
+
+		story, resp, err := s.client.StoryService.UpdateStory(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if story == nil {
+			return nil, resp, ErrWorkItemNotFound
+		}
+		return story, resp, nil
+	case EntityTypeBug:
+		if request.Effort != nil {
+			return nil, nil, errors.New("tapd: effort is not supported when updating bugs")
+		}
+
+		r := &UpdateBugRequest{
+			ID:           request.ID,
+			WorkspaceID:  request.WorkspaceID,
+			Title:        request.Name,
+			CurrentOwner: request.Owner,
+			Begin:        request.Begin,
+			Due:          request.Due,
+			CustomFields: request.CustomFields,
+		}
+		if request.Status != nil {
+			r.Status = NewEnum(*request.Status)
+		}
+		if request.IterationID != nil {
+			r.IterationID = NewEnum(strconv.FormatInt(*request.IterationID, 10))
+		}
+
+		bug, resp, err := s.client.BugService.UpdateBug(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if bug == nil {
+			return nil, resp, ErrWorkItemNotFound
+		}
+		return bug, resp, nil
+	case EntityTypeTask:
+		r := &UpdateTaskRequest{
+			ID:           request.ID,
+			WorkspaceID:  request.WorkspaceID,
+			CurrentUser:  request.CurrentUser,
+			Name:         request.Name,
+			Owner:        request.Owner,
+			IterationID:  request.IterationID,
+			Begin:        request.Begin,
+			Due:          request.Due,
+			Effort:       request.Effort,
+			CustomFields: request.CustomFields,
+		}
+		if request.Status != nil {
+			r.Status = new(TaskStatus(*request.Status))
+		}
+
+		task, resp, err := s.client.TaskService.UpdateTask(ctx, r, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		if task == nil {
+			return nil, resp, ErrWorkItemNotFound
+		}
+		return task, resp, nil
+	default:
+		return nil, nil, fmt.Errorf("tapd: work items of entity type [%s] not supported", entityType)
+	}
+}
+
+func (s *workItemService) GetWorkItemsByView(
+	ctx context.Context, request *GetWorkItemsByViewRequest, opts ...RequestOption,
+) ([]WorkItem, *Response, error) {
+	view := *request
+	if view.ViewURL != nil {
+		parsed, err := parseViewURL(*view.ViewURL)
+		if err != nil {
+			return nil, nil, err
+		}
+		if view.EntityType == nil {
+			view.EntityType = parsed.EntityType
+		}
+		if view.WorkspaceID == nil {
+			view.WorkspaceID = parsed.WorkspaceID
+		}
+		if view.ViewConfID == nil {
+			view.ViewConfID = parsed.ViewConfID
+		}
+		if view.QueryToken == nil {
+			view.QueryToken = parsed.QueryToken
+		}
+	}
+	if view.WorkspaceID == nil || view.ViewConfID == nil {
+		return nil, nil, errors.New("tapd: workspace id and view conf id are required")
+	}
+
+	switch entityType := deref(view.EntityType); entityType {
+	case EntityTypeStory:
+		stories, resp, err := s.client.StoryService.GetStoriesByViewConfID(ctx, &GetStoriesByViewConfIDRequest{
+			ViewConfID:  view.ViewConfID,
+			CurrentUser: view.CurrentUser,
+			QueryToken:  view.QueryToken,
+			GetStoriesRequest: GetStoriesRequest{
+				WorkspaceID: view.WorkspaceID,
+				Limit:       view.Limit,
+				Page:        view.Page,
+				Fields:      view.Fields,
+			},
+		}, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(stories), resp, nil
+	case EntityTypeBug:
+		bugs, resp, err := s.client.BugService.GetBugsByViewConfID(ctx, &GetBugsByViewConfIDRequest{
+			ViewConfID:  view.ViewConfID,
+			CurrentUser: view.CurrentUser,
+			QueryToken:  view.QueryToken,
+			GetBugsRequest: GetBugsRequest{
+				WorkspaceID: view.WorkspaceID,
+				Limit:       view.Limit,
+				Page:        view.Page,
+				Fields:      view.Fields,
+			},
+		}, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(bugs), resp, nil
+	case EntityTypeTask:
+		tasks, resp, err := s.client.TaskService.GetTasksByViewConfID(ctx, &GetTasksByViewConfIDRequest{
+			ViewConfID:  view.ViewConfID,
+			CurrentUser: view.CurrentUser,
+			QueryToken:  view.QueryToken,
+			GetTasksRequest: GetTasksRequest{
+				WorkspaceID: view.WorkspaceID,
+				Limit:       view.Limit,
+				Page:        view.Page,
+				Fields:      view.Fields,
+			},
+		}, opts...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return toWorkItems(tasks), resp, nil
+	default:
+		return nil, nil, fmt.Errorf("tapd: work items of entity type [%s] not supported", entityType)
+	}
+}
+
+// parseViewURL extracts whichever of the entity type, workspace ID, view conf
+// ID and query token are present in a TAPD list page link such as
+// https://www.tapd.cn/tapd_fe/11112222/story/list?conf_id=1111122233301000001&queryToken=xxx.
+func parseViewURL(rawURL string) (*GetWorkItemsByViewRequest, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("tapd: invalid view url [%s]: %w", rawURL, err)
+	}
+
+	view := new(GetWorkItemsByViewRequest)
+	for segment := range strings.SplitSeq(strings.Trim(u.Path, "/"), "/") {
+		if view.WorkspaceID == nil {
+			if id, err := strconv.Atoi(segment); err == nil {
+				view.WorkspaceID = new(id)
+				continue
+			}
+		}
+		if view.EntityType == nil {
+			switch segment := strings.ToLower(segment); {
+			case strings.HasPrefix(segment, "stor"):
+				view.EntityType = new(EntityTypeStory)
+			case strings.HasPrefix(segment, "bug"):
+				view.EntityType = new(EntityTypeBug)
+			case strings.HasPrefix(segment, "task"):
+				view.EntityType = new(EntityTypeTask)
+			}
+		}
+	}
+
+	query := u.Query()
+	for _, key := range []string{"conf_id", "view_conf_id"} {
+		if value := query.Get(key); value != "" {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("tapd: invalid view conf id [%s]", value)
+			}
+			view.ViewConfID = new(id)
+			break
+		}
+	}
+	for _, key := range []string{"queryToken", "query_token"} {
+		if value := query.Get(key); value != "" {
+			view.QueryToken = new(value)
+			break
+		}
+	}
+
+	return view, nil
+}
+
+func toWorkItems[T WorkItem](items []T) []WorkItem {
+	workItems := make([]WorkItem, 0, len(items))
+	for _, item := range items {
+		workItems = append(workItems, item)
+	}
+	return workItems
+}
@@ -0,0 +1,254 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemService_GetWorkItem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/stories", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "1111112222001063941", r.URL.Query().Get("id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_stories_by_view_conf_id.json"))
+	}))
+
+	item, _, err := client.WorkItemService.GetWorkItem(ctx, &GetWorkItemRequest{
+		EntityType:  new(EntityTypeStory),
+		WorkspaceID: new(11112222),
+		ID:          new(int64(1111112222001063941)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, EntityTypeStory, item.GetEntityType())
+	assert.Equal(t, "1111112222001063941", item.GetID())
+	assert.Equal(t, "11112222", item.GetWorkspaceID())
+	assert.Equal(t, "视图需求一", item.GetName())
+	assert.Equal(t, string(StoryStatusPlanning), item.GetStatus())
+	assert.Equal(t, "2025-07-08 15:22:49", item.GetCreated())
+
+	story, ok := item.(*Story)
+	require.True(t, ok)
+	assert.Equal(t, "xinweihe", story.Creator)
+}
+
+func TestWorkItemService_GetWorkItem_NotFound(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tasks", r.URL.Path)
+
+		_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+	}))
+
+	_, _, err := client.WorkItemService.GetWorkItem(ctx, &GetWorkItemRequest{
+		EntityType:  new(EntityTypeTask),
+		WorkspaceID: new(11112222),
+		ID:          new(int64(1)),
+	})
+	assert.ErrorIs(t, err, ErrWorkItemNotFound)
+}
+
+func TestWorkItemService_GetWorkItem_RequiresIDAndEntityType(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+
+	_, _, err := client.WorkItemService.GetWorkItem(ctx, &GetWorkItemRequest{
+		WorkspaceID: new(11112222),
+		ID:          new(int64(1)),
+	})
+	assert.EqualError(t, err, "tapd: entity type is required")
+
+	_, _, err = client.WorkItemService.GetWorkItem(ctx, &GetWorkItemRequest{
+		EntityType:  new(EntityTypeStory),
+		WorkspaceID: new(11112222),
+	})
+	assert.EqualError(t, err, "tapd: work item id is required")
+}
+
+func TestWorkItemService_GetWorkItems(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "张三", r.URL.Query().Get("current_owner"))
+		assert.Equal(t, "新|重新打开", r.URL.Query().Get("status"))
+		assert.Equal(t, "1111", r.URL.Query().Get("iteration_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs.json"))
+	}))
+
+	items, _, err := client.WorkItemService.GetWorkItems(ctx, &GetWorkItemsRequest{
+		EntityType:  new(EntityTypeBug),
+		WorkspaceID: new(11112222),
+		Owner:       new("张三"),
+		Status:      In("新", "重新打开"),
+		IterationID: new(int64(1111)),
+	})
+	require.NoError(t, err)
+	require.True(t, len(items) > 0)
+	assert.Equal(t, EntityTypeBug, items[0].GetEntityType())
+	assert.Equal(t, "11111222333001000268", items[0].GetID())
+	assert.Equal(t, "计算不正确", items[0].GetName())
+	assert.Equal(t, "0", items[0].GetIterationID())
+	assert.Equal(t, CustomFieldValue("field"), items[0].GetCustomFields().Get("custom_field_7"))
+
+	_, _, err = client.WorkItemService.GetWorkItems(ctx, &GetWorkItemsRequest{
+		EntityType: new(EntityType("iteration")),
+	})
+	assert.Error(t, err)
+}
+
+func TestWorkItemService_UpdateWorkItem(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/tasks", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, float64(1111112222001138994), req["id"])
+		assert.Equal(t, float64(11112222), req["workspace_id"])
+		assert.Equal(t, "Updated Task", req["name"])
+		assert.Equal(t, "progressing", req["status"])
+		assert.Equal(t, "value", req["custom_field_17"])
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/task/update_task.json"))
+	}))
+
+	item, _, err := client.WorkItemService.UpdateWorkItem(ctx, &UpdateWorkItemRequest{
+		EntityType:   new(EntityTypeTask),
+		WorkspaceID:  new(11112222),
+		ID:           new(int64(1111112222001138994)),
+		Name:         new("Updated Task"),
+		Status:       new(string(TaskStatusProgressing)),
+		CustomFields: CustomFields{"custom_field_17": "value"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, EntityTypeTask, item.GetEntityType())
+	assert.Equal(t, "1111112222001138994", item.GetID())
+	assert.Equal(t, "Updated Task", item.GetName())
+
+	_, _, err = client.WorkItemService.UpdateWorkItem(ctx, &UpdateWorkItemRequest{
+		EntityType: new(EntityTypeBug),
+		Effort:     new("1"),
+	})
+	assert.Error(t, err)
+}
+
+func TestWorkItemService_UpdateWorkItem_NotFound(t *testing.T) {
+	for _, entityType := range []EntityType{EntityTypeStory, EntityTypeBug, EntityTypeTask} {
+		t.Run(string(entityType), func(t *testing.T) {
+			_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"status":1,"data":{},"info":"success"}`))
+			}))
+
+			item, _, err := client.WorkItemService.UpdateWorkItem(ctx, &UpdateWorkItemRequest{
+				EntityType:  new(entityType),
+				WorkspaceID: new(11112222),
+				ID:          new(int64(1)),
+				Name:        new("name"),
+			})
+			assert.ErrorIs(t, err, ErrWorkItemNotFound)
+			assert.Nil(t, item)
+		})
+	}
+}
+
+func TestWorkItemService_GetWorkItemsByView(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		switch r.URL.Path {
+		case "/bugs/get_bugs_by_view_conf_id":
+			assert.Equal(t, "1111122233301000001", r.URL.Query().Get("view_conf_id"))
+			assert.Equal(t, "token123", r.URL.Query().Get("query_token"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bugs_by_view_conf_id.json"))
+		case "/tasks/get_tasks_by_view_conf_id":
+			assert.Equal(t, "1111122233301000003", r.URL.Query().Get("view_conf_id"))
+			assert.Empty(t, r.URL.Query().Get("query_token"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/task/get_tasks_by_view_conf_id.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	items, _, err := client.WorkItemService.GetWorkItemsByView(ctx, &GetWorkItemsByViewRequest{
+		ViewURL: new("https://www.tapd.cn/tapd_fe/11112222/bug/list?conf_id=1111122233301000001&queryToken=token123"),
+		Page:    new(2),
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, EntityTypeBug, items[0].GetEntityType())
+	assert.Equal(t, "视图缺陷一", items[0].GetName())
+
+	items, _, err = client.WorkItemService.GetWorkItemsByView(ctx, &GetWorkItemsByViewRequest{
+		EntityType:  new(EntityTypeTask),
+		WorkspaceID: new(11112222),
+		ViewConfID:  new(int64(1111122233301000003)),
+		Page:        new(2),
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, EntityTypeTask, items[1].GetEntityType())
+	assert.Equal(t, "视图任务二", items[1].GetName())
+
+	_, _, err = client.WorkItemService.GetWorkItemsByView(ctx, &GetWorkItemsByViewRequest{
+		ViewURL: new("https://www.tapd.cn/11112222/prong/stories/stories_list"),
+	})
+	assert.EqualError(t, err, "tapd: workspace id and view conf id are required")
+}
+
+func TestParseViewURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want *GetWorkItemsByViewRequest
+	}{
+		{
+			url: "https://www.tapd.cn/tapd_fe/11112222/story/list?categoryId=0&conf_id=1111122233301000001&queryToken=abc",
+			want: &GetWorkItemsByViewRequest{
+				EntityType:  new(EntityTypeStory),
+				WorkspaceID: new(11112222),
+				ViewConfID:  new(int64(1111122233301000001)),
+				QueryToken:  new("abc"),
+			},
+		},
+		{
+			url: "https://www.tapd.cn/11112222/bugtrace/bugreports/my_view?view_conf_id=1111122233301000002",
+			want: &GetWorkItemsByViewRequest{
+				EntityType:  new(EntityTypeBug),
+				WorkspaceID: new(11112222),
+				ViewConfID:  new(int64(1111122233301000002)),
+			},
+		},
+		{
+			url: "https://www.tapd.cn/11112222/prong/tasks?conf_id=1111122233301000003",
+			want: &GetWorkItemsByViewRequest{
+				EntityType:  new(EntityTypeTask),
+				WorkspaceID: new(11112222),
+				ViewConfID:  new(int64(1111122233301000003)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			got, err := parseViewURL(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := parseViewURL("https://www.tapd.cn/11112222/prong/tasks?conf_id=abc")
+	assert.EqualError(t, err, "tapd: invalid view conf id [abc]")
+}
+
+func TestCommitObject_WorkItem(t *testing.T) {
+	assert.Nil(t, (&CommitObject{}).WorkItem())
+	assert.Equal(t, EntityTypeBug, (&CommitObject{Bug: &Bug{ID: "1"}}).WorkItem().GetEntityType())
+	assert.Equal(t, "2", (&CommitObject{Task: &Task{ID: "2"}}).WorkItem().GetID())
+}
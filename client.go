@@ -50,25 +50,30 @@ type Client struct {
 	httpClient *http.Client
 
 	// services used for talking to different parts of the Tapd API.
-	StoryService      StoryService
-	BugService        BugService
-	IterationService  IterationService
-	TaskService       TaskService
-	CommentService    CommentService
-	ReportService     ReportService
-	AttachmentService AttachmentService
-	TimesheetService  TimesheetService
-	WorkspaceService  WorkspaceService
-	LabelService      LabelService
-	MeasureService    MeasureService
-	UserService       UserService
-	WorkflowService   WorkflowService
-	SettingService    SettingService
-	TestService       TestService
-	BoardService      BoardService
-	WikiService       WikiService
-	ReleaseService    ReleaseService
-	SourceService     SourceService
+	StoryService        StoryService
+	BugService          BugService
+	IterationService    IterationService
+	TaskService         TaskService
+	CommentService      CommentService
+	ReportService       ReportService
+	AttachmentService   AttachmentService
+	TimesheetService    TimesheetService
+	WorkspaceService    WorkspaceService
+	ProgramService      ProgramService
+	LabelService        LabelService
+	MeasureService      MeasureService
+	UserService         UserService
+	WorkflowService     WorkflowService
+	SettingService      SettingService
+	TestService         TestService
+	BoardService        BoardService
+	WikiService         WikiService
+	ReleaseService      ReleaseService
+	SourceService       SourceService
+	WorkItemService     WorkItemService
+	StorageService      StorageService
+	LiteWorkItemService LiteWorkItemService
+	LiteSpaceService    LiteSpaceService
 }
 
 // NewClient returns a new Tapd API client.
@@ -116,6 +121,7 @@ func newClient(opts ...ClientOption) (*Client, error) {
 	c.AttachmentService = NewAttachmentService(c)
 	c.TimesheetService = NewTimesheetService(c)
 	c.WorkspaceService = NewWorkspaceService(c)
+	c.ProgramService = NewProgramService(c)
 	c.LabelService = NewLabelService(c)
 	c.MeasureService = NewMeasureService(c)
 	c.UserService = NewUserService(c)
@@ -126,6 +132,10 @@ func newClient(opts ...ClientOption) (*Client, error) {
 	c.WikiService = NewWikiService(c)
 	c.ReleaseService = NewReleaseService(c)
 	c.SourceService = NewSourceService(c)
+	c.WorkItemService = NewWorkItemService(c)
+	c.StorageService = NewStorageService(c)
+	c.LiteWorkItemService = NewLiteWorkItemService(c)
+	c.LiteSpaceService = NewLiteSpaceService(c)
 
 	return c, nil
 }
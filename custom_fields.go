@@ -0,0 +1,552 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// -----------------------------------------------------------------------------
+// CustomFields holds the custom field values of a work item, keyed by the
+// field identifier, such as custom_field_17 or custom_plan_field_3.
+//
+// Responses fill it while unmarshalling, requests merge it into the body when
+// marshalling, so any custom field can be read or written without knowing the
+// matching struct field.
+// -----------------------------------------------------------------------------
+
+type CustomFields map[string]string
+
+const (
+	customFieldPrefix     = "custom_field_"
+	customPlanFieldPrefix = "custom_plan_field_"
+)
+
+// IsCustomField reports whether the field is a custom field identifier.
+func IsCustomField(field string) bool {
+	return strings.HasPrefix(field, customFieldPrefix) || strings.HasPrefix(field, customPlanFieldPrefix)
+}
+
+// ParseCustomFields collects the custom fields of a JSON object.
+//
+// Only keys starting with prefix followed by a custom field identifier are
+// collected, and the prefix is stripped from the result. Webhook update events
+// use the "old_" and "new_" prefixes, the other payloads use an empty prefix.
+func ParseCustomFields(data []byte, prefix string) (CustomFields, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	var fields CustomFields
+	for key, value := range raw {
+		field, ok := strings.CutPrefix(key, prefix)
+		if !ok || !IsCustomField(field) {
+			continue
+		}
+		if fields == nil {
+			fields = make(CustomFields)
+		}
+		fields[field] = stringifyJSONRaw(value)
+	}
+
+	return fields, nil
+}
+
+// Get returns the value of the field, or an empty value if it is not set.
+func (f CustomFields) Get(field string) CustomFieldValue {
+	return CustomFieldValue(f[field])
+}
+
+// Lookup returns the value of the field and whether it is set.
+func (f CustomFields) Lookup(field string) (CustomFieldValue, bool) {
+	value, ok := f[field]
+	return CustomFieldValue(value), ok
+}
+
+// Set sets the value of the field.
+//
+// Strings are kept as is, time.Time values are formatted as "2006-01-02 15:04:05",
+// string slices are joined with "|", and everything else uses fmt.Sprint.
+func (f *CustomFields) Set(field string, value any) {
+	if *f == nil {
+		*f = make(CustomFields)
+	}
+	(*f)[field] = formatCustomFieldValue(value)
+}
+
+// Delete removes the field.
+func (f CustomFields) Delete(field string) {
+	delete(f, field)
+}
+
+// Fields returns the sorted field identifiers.
+func (f CustomFields) Fields() []string {
+	return slices.Sorted(maps.Keys(f))
+}
+
+func formatCustomFieldValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case CustomFieldValue:
+		return string(v)
+	case time.Time:
+		return v.Format(time.DateTime)
+	case []string:
+		return strings.Join(v, "|")
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// marshalWithCustomFields marshals v and merges the custom fields into the
+// resulting JSON object. Fields already present in v take precedence.
+func marshalWithCustomFields(v any, fields CustomFields) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(fields) == 0 {
+		return data, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	for field, value := range fields {
+		if _, ok := raw[field]; ok {
+			continue
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		raw[field] = b
+	}
+
+	return json.Marshal(raw)
+}
+
+// -----------------------------------------------------------------------------
+// CustomFieldValue is the raw string value of a custom field with typed
+// conversions.
+// -----------------------------------------------------------------------------
+
+type CustomFieldValue string
+
+func (v CustomFieldValue) String() string {
+	return string(v)
+}
+
+// IsEmpty reports whether the value is empty.
+func (v CustomFieldValue) IsEmpty() bool {
+	return strings.TrimSpace(string(v)) == ""
+}
+
+// Int converts the value to an int64.
+func (v CustomFieldValue) Int() (int64, error) {
+	return strconv.ParseInt(strings.TrimSpace(string(v)), 10, 64)
+}
+
+// Float converts the value to a float64.
+func (v CustomFieldValue) Float() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(string(v)), 64)
+}
+
+// Bool converts the value to a bool. Besides the strconv forms, "是" and "否"
+// are accepted.
+func (v CustomFieldValue) Bool() (bool, error) {
+	switch s := strings.TrimSpace(string(v)); s {
+	case "是":
+		return true, nil
+	case "否":
+		return false, nil
+	default:
+		return strconv.ParseBool(s)
+	}
+}
+
+// Time converts the value to a time.Time in the local time zone. Both the
+// "2006-01-02" and "2006-01-02 15:04:05" layouts are accepted.
+func (v CustomFieldValue) Time() (time.Time, error) {
+	s := strings.TrimSpace(string(v))
+	for _, layout := range []string{time.DateTime, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("tapd: invalid custom field time value %q", s)
+}
+
+// Values splits a multi-value field (checkbox, multi select, user chooser) into
+// its values. Both "|" and ";" separators are accepted.
+func (v CustomFieldValue) Values() []string {
+	fields := strings.FieldsFunc(string(v), func(r rune) bool {
+		return r == '|' || r == ';'
+	})
+
+	values := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			values = append(values, field)
+		}
+	}
+	return values
+}
+
+// -----------------------------------------------------------------------------
+// CustomFieldResolver resolves custom fields by their display name.
+// -----------------------------------------------------------------------------
+
+type (
+	// CustomFieldDefinition 自定义字段定义
+	CustomFieldDefinition struct {
+		Field   string               // 自定义字段标识（英文名），如 custom_field_17
+		Name    string               // 自定义字段显示名称
+		Type    string               // 输入类型
+		Options []*CustomFieldOption // 候选值
+		Enabled bool                 // 是否启用
+	}
+
+	// CustomFieldOption 自定义字段候选值
+	CustomFieldOption struct {
+		Value string // 值
+		Label string // 显示名称
+	}
+)
+
+// ErrCustomFieldNotFound is returned when a custom field cannot be resolved.
+var ErrCustomFieldNotFound = errors.New("tapd: custom field not found")
+
+// CustomFieldResolver maps custom field display names to their identifiers for
+// a single workspace and entity type.
+type CustomFieldResolver struct {
+	definitions []*CustomFieldDefinition
+	byName      map[string]*CustomFieldDefinition
+	byField     map[string]*CustomFieldDefinition
+}
+
+// NewCustomFieldResolver creates a resolver from the given definitions.
+//
+// When several definitions share a display name, enabled ones take precedence.
+func NewCustomFieldResolver(definitions []*CustomFieldDefinition) *CustomFieldResolver {
+	r := &CustomFieldResolver{
+		definitions: definitions,
+		byName:      make(map[string]*CustomFieldDefinition, len(definitions)),
+		byField:     make(map[string]*CustomFieldDefinition, len(definitions)),
+	}
+	for _, definition := range definitions {
+		if definition == nil {
+			continue
+		}
+		r.byField[definition.Field] = definition
+		if exists, ok := r.byName[definition.Name]; ok && exists.Enabled && !definition.Enabled {
+			continue
+		}
+		r.byName[definition.Name] = definition
+	}
+	return r
+}
+
+// LoadCustomFieldResolver fetches the custom field settings of the workspace
+// for the entity type and creates a resolver from them.
+//
+// Supported entity types are story, bug and task.
+func LoadCustomFieldResolver(
+	ctx context.Context, client *Client, entityType EntityType, workspaceID int, opts ...RequestOption,
+) (*CustomFieldResolver, error) {
+	var definitions []*CustomFieldDefinition
+
+	switch entityType {
+	case EntityTypeStory:
+		settings, _, err := client.StoryService.GetStoryCustomFieldsSettings(ctx, &GetStoryCustomFieldsSettingsRequest{
+			WorkspaceID: new(workspaceID),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, setting := range settings {
+			definitions = append(definitions, setting.Definition())
+		}
+	case EntityTypeBug:
+		settings, _, err := client.BugService.GetBugCustomFieldsSettings(ctx, &GetBugCustomFieldsSettingsRequest{
+			WorkspaceID: new(workspaceID),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, setting := range settings {
+			definitions = append(definitions, setting.Definition())
+		}
+	case EntityTypeTask:
+		settings, _, err := client.TaskService.GetTaskCustomFieldsSettings(ctx, &GetTaskCustomFieldsSettingsRequest{
+			WorkspaceID: new(workspaceID),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, setting := range settings {
+			definitions = append(definitions, setting.Definition())
+		}
+	default:
+		return nil, fmt.Errorf("tapd: custom fields of entity type [%s] not supported", entityType)
+	}
+
+	return NewCustomFieldResolver(definitions), nil
+}
+
+// Definitions returns all definitions of the resolver.
+func (r *CustomFieldResolver) Definitions() []*CustomFieldDefinition {
+	return r.definitions
+}
+
+// Definition returns the definition matching the display name or the field
+// identifier.
+func (r *CustomFieldResolver) Definition(name string) (*CustomFieldDefinition, bool) {
+	if definition, ok := r.byName[name]; ok {
+		return definition, true
+	}
+	definition, ok := r.byField[name]
+	return definition, ok
+}
+
+// Field returns the field identifier of the display name.
+func (r *CustomFieldResolver) Field(name string) (string, bool) {
+	definition, ok := r.Definition(name)
+	if !ok {
+		return "", false
+	}
+	return definition.Field, true
+}
+
+// Name returns the display name of the field identifier.
+func (r *CustomFieldResolver) Name(field string) (string, bool) {
+	definition, ok := r.byField[field]
+	if !ok {
+		return "", false
+	}
+	return definition.Name, true
+}
+
+// Get returns the value of the custom field with the display name.
+func (r *CustomFieldResolver) Get(fields CustomFields, name string) (CustomFieldValue, bool) {
+	field, ok := r.Field(name)
+	if !ok {
+		return "", false
+	}
+	return fields.Lookup(field)
+}
+
+// Set sets the value of the custom field with the display name.
+//
+// For fields with options, an option label is translated to its value.
+func (r *CustomFieldResolver) Set(fields *CustomFields, name string, value any) error {
+	definition, ok := r.Definition(name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrCustomFieldNotFound, name)
+	}
+
+	formatted := formatCustomFieldValue(value)
+	for _, option := range definition.Options {
+		if option.Label == formatted {
+			formatted = option.Value
+			break
+		}
+	}
+
+	fields.Set(definition.Field, formatted)
+	return nil
+}
+
+// Named returns the custom field values keyed by their display names. Fields
+// without a definition are keyed by their identifier.
+func (r *CustomFieldResolver) Named(fields CustomFields) map[string]CustomFieldValue {
+	named := make(map[string]CustomFieldValue, len(fields))
+	for field, value := range fields {
+		if name, ok := r.Name(field); ok {
+			field = name
+		}
+		named[field] = CustomFieldValue(value)
+	}
+	return named
+}
+
+func newCustomFieldDefinition(field, name, typ string, options *string, enabled string) *CustomFieldDefinition {
+	return &CustomFieldDefinition{
+		Field:   field,
+		Name:    name,
+		Type:    typ,
+		Options: parseCustomFieldOptions(options),
+		Enabled: enabled == "1",
+	}
+}
+
+// parseCustomFieldOptions parses options in either the "A|B|C" form or the
+// {"value":"label"} JSON form.
+func parseCustomFieldOptions(options *string) []*CustomFieldOption {
+	if options == nil || strings.TrimSpace(*options) == "" {
+		return nil
+	}
+
+	if result, ok := parseCustomFieldOptionsJSON(*options); ok {
+		return result
+	}
+
+	values := strings.Split(*options, "|")
+	result := make([]*CustomFieldOption, 0, len(values))
+	for _, value := range values {
+		result = append(result, &CustomFieldOption{Value: value, Label: value})
+	}
+	return result
+}
+
+// parseCustomFieldOptionsJSON parses options in the {"value":"label"} JSON
+// form, keeping the order of TAPD.
+func parseCustomFieldOptionsJSON(options string) ([]*CustomFieldOption, bool) {
+	decoder := json.NewDecoder(strings.NewReader(options))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, false
+	}
+
+	var result []*CustomFieldOption
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, false
+		}
+		value, ok := token.(string)
+		if !ok {
+			return nil, false
+		}
+		var label string
+		if err := decoder.Decode(&label); err != nil {
+			return nil, false
+		}
+		result = append(result, &CustomFieldOption{Value: value, Label: label})
+	}
+	if token, err := decoder.Token(); err != nil || token != json.Delim('}') {
+		return nil, false
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, false
+	}
+	return result, true
+}
+
+// -----------------------------------------------------------------------------
+// Declarative synchronization of dropdown custom field options: the desired
+// options are diffed against the current settings and only changed fields
+// are updated.
+// -----------------------------------------------------------------------------
+
+// CustomFieldOptionsChange 自定义字段候选值变更
+type CustomFieldOptionsChange struct {
+	Field   string   // 自定义字段标识（英文名）
+	Name    string   // 自定义字段显示名称
+	Options []string // 同步后的全部候选值
+	Added   []string // 新增的候选值
+	Removed []string // 删除的候选值
+}
+
+// PlanStoryCustomFieldOptions diffs the desired options, keyed by display name
+// or field identifier, against the story custom field settings of the
+// workspace. Fields whose options already match, in the same order, produce
+// no change.
+//
+// Options are written in the "A|B|C" form, so an option may not contain "|",
+// and fields whose current option values differ from their labels cannot be
+// changed without losing those values.
+func PlanStoryCustomFieldOptions(
+	ctx context.Context, client *Client, workspaceID int, desired map[string][]string, opts ...RequestOption,
+) ([]*CustomFieldOptionsChange, error) {
+	resolver, err := LoadCustomFieldResolver(ctx, client, EntityTypeStory, workspaceID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*CustomFieldOptionsChange, 0, len(desired))
+	names := make(map[string]string, len(desired))
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		definition, ok := resolver.Definition(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrCustomFieldNotFound, name)
+		}
+		if other, ok := names[definition.Field]; ok {
+			return nil, fmt.Errorf("tapd: custom field [%s] desired as both [%s] and [%s]", definition.Field, other, name)
+		}
+		names[definition.Field] = name
+
+		options := desired[name]
+		for _, option := range options {
+			if strings.Contains(option, "|") {
+				return nil, fmt.Errorf("tapd: option [%s] of custom field [%s] contains \"|\"", option, definition.Field)
+			}
+		}
+
+		current := make([]string, 0, len(definition.Options))
+		for _, option := range definition.Options {
+			current = append(current, option.Label)
+		}
+		if slices.Equal(current, options) {
+			continue
+		}
+
+		if slices.ContainsFunc(definition.Options, func(option *CustomFieldOption) bool {
+			return option.Value != option.Label
+		}) {
+			return nil, fmt.Errorf("tapd: options of custom field [%s] have values different from their labels", definition.Field)
+		}
+
+		change := &CustomFieldOptionsChange{
+			Field:   definition.Field,
+			Name:    definition.Name,
+			Options: options,
+		}
+		for _, option := range options {
+			if !slices.Contains(current, option) {
+				change.Added = append(change.Added, option)
+			}
+		}
+		for _, option := range current {
+			if !slices.Contains(options, option) {
+				change.Removed = append(change.Removed, option)
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// SyncStoryCustomFieldOptions plans the changes with
+// PlanStoryCustomFieldOptions and applies them through
+// SettingService.UpdateStoryCustomFieldOptions. It returns the applied
+// changes, which are incomplete when an update fails.
+func SyncStoryCustomFieldOptions(
+	ctx context.Context, client *Client, workspaceID int, desired map[string][]string, opts ...RequestOption,
+) ([]*CustomFieldOptionsChange, error) {
+	changes, err := PlanStoryCustomFieldOptions(ctx, client, workspaceID, desired, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, change := range changes {
+		if _, _, err := client.SettingService.UpdateStoryCustomFieldOptions(ctx, &UpdateStoryCustomFieldOptionsRequest{
+			WorkspaceID: new(workspaceID),
+			CustomField: new(change.Field),
+			Options:     NewEnum(change.Options...),
+		}, opts...); err != nil {
+			return changes[:i], fmt.Errorf("tapd: sync options of custom field [%s]: %w", change.Field, err)
+		}
+	}
+
+	return changes, nil
+}
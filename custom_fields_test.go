@@ -0,0 +1,228 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFields_Unmarshal(t *testing.T) {
+	var bug Bug
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "1",
+		"title": "bug",
+		"custom_field_one": "XSS注入",
+		"custom_field_7": "field",
+		"custom_field_101": null,
+		"custom_plan_field_2": "3"
+	}`), &bug))
+
+	assert.Equal(t, "1", bug.ID)
+	assert.Equal(t, "XSS注入", bug.CustomFieldOne)
+	assert.Equal(t, []string{"custom_field_101", "custom_field_7", "custom_field_one", "custom_plan_field_2"}, bug.CustomFields.Fields())
+	assert.Equal(t, CustomFieldValue("XSS注入"), bug.CustomFields.Get("custom_field_one"))
+	assert.Equal(t, CustomFieldValue("field"), bug.CustomFields.Get("custom_field_7"))
+	assert.True(t, bug.CustomFields.Get("custom_field_101").IsEmpty())
+
+	value, ok := bug.CustomFields.Lookup("custom_plan_field_2")
+	assert.True(t, ok)
+	n, err := value.Int()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	_, ok = bug.CustomFields.Lookup("custom_field_2")
+	assert.False(t, ok)
+}
+
+func TestCustomFields_Marshal(t *testing.T) {
+	request := &UpdateBugRequest{
+		ID:             new(int64(1)),
+		CustomFieldOne: new("struct"),
+	}
+	request.CustomFields.Set("custom_field_one", "map")
+	request.CustomFields.Set("custom_field_17", 12)
+	request.CustomFields.Set("custom_field_18", []string{"A", "B"})
+	request.CustomFields.Set("custom_field_19", time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local))
+
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.Equal(t, float64(1), body["id"])
+	assert.Equal(t, "struct", body["custom_field_one"])
+	assert.Equal(t, "12", body["custom_field_17"])
+	assert.Equal(t, "A|B", body["custom_field_18"])
+	assert.Equal(t, "2024-01-02 03:04:05", body["custom_field_19"])
+
+	data, err = json.Marshal(&CreateStoryRequest{Name: new("story")})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"story"}`, string(data))
+}
+
+func TestCustomFieldValue(t *testing.T) {
+	b, err := CustomFieldValue("是").Bool()
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	b, err = CustomFieldValue("false").Bool()
+	assert.NoError(t, err)
+	assert.False(t, b)
+
+	f, err := CustomFieldValue(" 1.5 ").Float()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, f)
+
+	tm, err := CustomFieldValue("2024-01-02").Time()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local), tm)
+
+	tm, err = CustomFieldValue("2024-01-02 03:04:05").Time()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local), tm)
+
+	_, err = CustomFieldValue("tomorrow").Time()
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"A", "B", "C"}, CustomFieldValue("A|B; C").Values())
+	assert.Empty(t, CustomFieldValue("").Values())
+}
+
+func TestCustomFieldResolver(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bugs/custom_fields_settings", r.URL.Path)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		_, _ = w.Write(loadData(t, "internal/testdata/api/bug/get_bug_custom_fields_settings.json"))
+	}))
+
+	resolver, err := LoadCustomFieldResolver(ctx, client, EntityTypeBug, 11112222)
+	require.NoError(t, err)
+	require.Len(t, resolver.Definitions(), 1)
+
+	definition, ok := resolver.Definition("安全漏洞类型")
+	require.True(t, ok)
+	assert.Equal(t, "custom_field_one", definition.Field)
+	assert.Equal(t, "radio", definition.Type)
+	assert.True(t, definition.Enabled)
+	assert.Equal(t, []*CustomFieldOption{
+		{Value: "XSS注入", Label: "XSS注入"},
+		{Value: "SQL注入", Label: "SQL注入"},
+		{Value: "越权", Label: "越权"},
+	}, definition.Options)
+
+	field, ok := resolver.Field("安全漏洞类型")
+	assert.True(t, ok)
+	assert.Equal(t, "custom_field_one", field)
+
+	name, ok := resolver.Name("custom_field_one")
+	assert.True(t, ok)
+	assert.Equal(t, "安全漏洞类型", name)
+
+	var fields CustomFields
+	assert.NoError(t, resolver.Set(&fields, "安全漏洞类型", "越权"))
+	assert.ErrorIs(t, resolver.Set(&fields, "客户名称", "x"), ErrCustomFieldNotFound)
+	fields.Set("custom_field_7", "field")
+
+	value, ok := resolver.Get(fields, "安全漏洞类型")
+	assert.True(t, ok)
+	assert.Equal(t, CustomFieldValue("越权"), value)
+
+	assert.Equal(t, map[string]CustomFieldValue{
+		"安全漏洞类型":         "越权",
+		"custom_field_7": "field",
+	}, resolver.Named(fields))
+
+	_, err = LoadCustomFieldResolver(ctx, client, EntityType("iteration"), 11112222)
+	assert.Error(t, err)
+}
+
+func TestCustomFieldResolver_Options(t *testing.T) {
+	resolver := NewCustomFieldResolver([]*CustomFieldDefinition{
+		newCustomFieldDefinition("custom_field_1", "状态", "select", new(`{"1":"已实现","2":"未实现"}`), "0"),
+		newCustomFieldDefinition("custom_field_2", "状态", "select", new("A|B"), "1"),
+		newCustomFieldDefinition("custom_field_3", "实现", "select", new(`{"1":"已实现","2":"未实现"}`), "1"),
+	})
+
+	field, ok := resolver.Field("状态")
+	assert.True(t, ok)
+	assert.Equal(t, "custom_field_2", field)
+
+	var fields CustomFields
+	assert.NoError(t, resolver.Set(&fields, "实现", "未实现"))
+	assert.Equal(t, CustomFieldValue("2"), fields.Get("custom_field_3"))
+
+	assert.Equal(t, []*CustomFieldOption{
+		{Value: "2", Label: "未实现"},
+		{Value: "10", Label: "待定"},
+		{Value: "1", Label: "已实现"},
+	}, parseCustomFieldOptions(new(`{"2":"未实现","10":"待定","1":"已实现"}`)))
+	assert.Equal(t, []*CustomFieldOption{
+		{Value: `{"1":1}`, Label: `{"1":1}`},
+	}, parseCustomFieldOptions(new(`{"1":1}`)))
+}
+
+func TestSyncStoryCustomFieldOptions(t *testing.T) {
+	var updates []map[string]any
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/stories/custom_fields_settings":
+			assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+			_, _ = w.Write([]byte(`{"status":1,"data":[
+				{"CustomFieldConfig":{"custom_field":"custom_field_101","type":"select","name":"发布渠道","options":"应用商店|官网","enabled":"1"}},
+				{"CustomFieldConfig":{"custom_field":"custom_field_103","type":"select","name":"平台","options":"iOS|Android","enabled":"1"}},
+				{"CustomFieldConfig":{"custom_field":"custom_field_104","type":"select","name":"优先级","options":"{\"b\":\"高\",\"a\":\"低\"}","enabled":"1"}}
+			],"info":"success"}`))
+		case "/settings/update_story_custom_field_options":
+			var req map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			updates = append(updates, req)
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/update_story_custom_field_options.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	desired := map[string][]string{
+		"发布渠道":             {"官网", "小程序"},
+		"custom_field_103": {"iOS", "Android"},
+		"优先级":              {"高", "低"},
+	}
+
+	changes, err := PlanStoryCustomFieldOptions(ctx, client, 11112222, desired)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "custom_field_101", changes[0].Field)
+	assert.Equal(t, []string{"小程序"}, changes[0].Added)
+	assert.Equal(t, []string{"应用商店"}, changes[0].Removed)
+	assert.Empty(t, updates)
+
+	changes, err = SyncStoryCustomFieldOptions(ctx, client, 11112222, desired)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+	require.Len(t, updates, 1)
+	assert.Equal(t, "custom_field_101", updates[0]["custom_field"])
+	assert.Equal(t, "官网|小程序", updates[0]["options"])
+
+	_, err = SyncStoryCustomFieldOptions(ctx, client, 11112222, map[string][]string{"未知": {"a"}})
+	assert.ErrorIs(t, err, ErrCustomFieldNotFound)
+
+	updates = nil
+	_, err = SyncStoryCustomFieldOptions(ctx, client, 11112222, map[string][]string{"优先级": {"高", "中", "低"}})
+	assert.EqualError(t, err, "tapd: options of custom field [custom_field_104] have values different from their labels")
+
+	_, err = SyncStoryCustomFieldOptions(ctx, client, 11112222, map[string][]string{"平台": {"iOS|Android"}})
+	assert.EqualError(t, err, `tapd: option [iOS|Android] of custom field [custom_field_103] contains "|"`)
+
+	_, err = SyncStoryCustomFieldOptions(ctx, client, 11112222, map[string][]string{
+		"平台":               {"iOS"},
+		"custom_field_103": {"Android"},
+	})
+	assert.EqualError(t, err, "tapd: custom field [custom_field_103] desired as both [custom_field_103] and [平台]")
+	assert.Empty(t, updates)
+}
@@ -24,3 +24,12 @@ func stringifyJSONRaw(raw json.RawMessage) string {
 	_ = json.Unmarshal(raw, &value)
 	return value
 }
+
+// deref returns the value p points to, or the zero value if p is nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
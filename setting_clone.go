@@ -0,0 +1,121 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+type (
+	// CloneWorkspaceConfigRequest selects the configuration copied by CloneWorkspaceConfig.
+	CloneWorkspaceConfigRequest struct {
+		SrcWorkspaceID int      // [必须]源项目ID
+		WorkspaceID    int      // [必须]目标项目ID
+		WorkitemTypes  []string // 复制的需求类别名称，为空时复制全部
+		BugConfig      bool     // 是否复制缺陷配置
+		Creator        string   // 创建人
+	}
+
+	// CloneWorkspaceConfigResult reports what CloneWorkspaceConfig copied.
+	CloneWorkspaceConfigResult struct {
+		WorkitemTypes        []*StoryWorkitemType        // 目标项目中新建的需求类别
+		SkippedWorkitemTypes []string                    // 目标项目中已存在同名类别而跳过的需求类别名称
+		Templates            map[string][]*StoryTemplate // 新建需求类别ID => 随类别复制的需求模板
+		BugConfig            bool                        // 是否已复制缺陷配置
+	}
+)
+
+// CloneWorkspaceConfig copies the story workitem types, together with their
+// templates, and optionally the bug configuration from one workspace to
+// another.
+//
+// Workitem types whose name already exists in the target workspace are
+// skipped, so cloning again only copies what is missing. When a request
+// fails, the result reports what was copied before the failure.
+func CloneWorkspaceConfig(
+	ctx context.Context, client *Client, request *CloneWorkspaceConfigRequest, opts ...RequestOption,
+) (*CloneWorkspaceConfigResult, error) {
+	srcTypes, _, err := client.StoryService.GetStoryWorkitemTypes(ctx, &GetStoryWorkitemTypesRequest{
+		WorkspaceID: new(request.SrcWorkspaceID),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	dstTypes, _, err := client.StoryService.GetStoryWorkitemTypes(ctx, &GetStoryWorkitemTypesRequest{
+		WorkspaceID: new(request.WorkspaceID),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(dstTypes))
+	for _, workitemType := range dstTypes {
+		existing[workitemType.Name] = true
+	}
+
+	var creator *string
+	if request.Creator != "" {
+		creator = new(request.Creator)
+	}
+
+	result := &CloneWorkspaceConfigResult{
+		Templates: make(map[string][]*StoryTemplate),
+	}
+	for _, workitemType := range srcTypes {
+		if len(request.WorkitemTypes) > 0 && !slices.Contains(request.WorkitemTypes, workitemType.Name) {
+			continue
+		}
+		if existing[workitemType.Name] {
+			result.SkippedWorkitemTypes = append(result.SkippedWorkitemTypes, workitemType.Name)
+			continue
+		}
+
+		id, err := strconv.ParseInt(workitemType.ID, 10, 64)
+		if err != nil {
+			return result, fmt.Errorf("tapd: invalid workitem type id [%s]", workitemType.ID)
+		}
+
+		copied, _, err := client.SettingService.CopyStoryWorkitemType(ctx, &CopyStoryWorkitemTypeRequest{
+			WorkspaceID:    new(request.WorkspaceID),
+			SrcWorkspaceID: new(request.SrcWorkspaceID),
+			WorkitemTypeID: new(id),
+			Creator:        creator,
+		}, opts...)
+		if err != nil {
+			return result, fmt.Errorf("tapd: copy workitem type [%s]: %w", workitemType.Name, err)
+		}
+		if copied == nil {
+			return result, fmt.Errorf("tapd: copy workitem type [%s]: empty response", workitemType.Name)
+		}
+		result.WorkitemTypes = append(result.WorkitemTypes, copied)
+
+		copiedID, err := strconv.Atoi(copied.ID)
+		if err != nil {
+			return result, fmt.Errorf("tapd: invalid workitem type id [%s]", copied.ID)
+		}
+		templates, _, err := client.StoryService.GetStoryTemplates(ctx, &GetStoryTemplatesRequest{
+			WorkspaceID:    new(request.WorkspaceID),
+			WorkitemTypeID: new(copiedID),
+		}, opts...)
+		if err != nil {
+			return result, err
+		}
+		result.Templates[copied.ID] = templates
+	}
+
+	if request.BugConfig {
+		copied, _, err := client.SettingService.CopyBugConfig(ctx, &CopyBugConfigRequest{
+			WorkspaceID:    new(request.WorkspaceID),
+			SrcWorkspaceID: new(request.SrcWorkspaceID),
+			Creator:        creator,
+		}, opts...)
+		if err != nil {
+			return result, fmt.Errorf("tapd: copy bug config: %w", err)
+		}
+		result.BugConfig = copied
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneWorkspaceConfig(t *testing.T) {
+	var copiedTypes []string
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workitem_types":
+			if r.URL.Query().Get("workspace_id") == "11112222" {
+				_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_workitem_types.json"))
+				return
+			}
+			assert.Equal(t, "11113333", r.URL.Query().Get("workspace_id"))
+			_, _ = w.Write([]byte(`{"status":1,"data":[{"WorkitemType":{"id":"1111133333001000100","name":"任务"}}],"info":"success"}`))
+		case "/settings/copy_story_workitem_type":
+			var req struct {
+				WorkitemTypeID json.Number `json:"workitem_type_id"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			copiedTypes = append(copiedTypes, req.WorkitemTypeID.String())
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/copy_story_workitem_type.json"))
+		case "/stories/template_list":
+			assert.Equal(t, "11113333", r.URL.Query().Get("workspace_id"))
+			assert.Equal(t, "1111133333001000201", r.URL.Query().Get("workitem_type_id"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_templates.json"))
+		case "/settings/copy_bug_config":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/copy_bug_config.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	result, err := CloneWorkspaceConfig(ctx, client, &CloneWorkspaceConfigRequest{
+		SrcWorkspaceID: 11112222,
+		WorkspaceID:    11113333,
+		BugConfig:      true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1111112222001000103"}, copiedTypes)
+	require.Len(t, result.WorkitemTypes, 1)
+	assert.Equal(t, "1111133333001000201", result.WorkitemTypes[0].ID)
+	assert.Equal(t, []string{"任务"}, result.SkippedWorkitemTypes)
+	assert.NotEmpty(t, result.Templates["1111133333001000201"])
+	assert.True(t, result.BugConfig)
+}
+
+func TestCloneWorkspaceConfig_EmptyCopy(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workitem_types":
+			if r.URL.Query().Get("workspace_id") == "11112222" {
+				_, _ = w.Write(loadData(t, "internal/testdata/api/story/get_story_workitem_types.json"))
+				return
+			}
+			_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+		case "/settings/copy_story_workitem_type":
+			_, _ = w.Write([]byte(`{"status":1,"data":{},"info":"success"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	_, err := CloneWorkspaceConfig(ctx, client, &CloneWorkspaceConfigRequest{
+		SrcWorkspaceID: 11112222,
+		WorkspaceID:    11113333,
+	})
+	assert.ErrorContains(t, err, "empty response")
+}
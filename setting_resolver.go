@@ -0,0 +1,222 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// settingPageLimit is the page size used when loading all settings of a workspace.
+const settingPageLimit = 200
+
+type (
+	// StorySettings holds the settings referenced by a story.
+	StorySettings struct {
+		Module  *Module  // 模块
+		Version *Version // 版本
+		Feature *Feature // 特性
+	}
+
+	// BugSettings holds the settings referenced by a bug.
+	BugSettings struct {
+		Module        *Module  // 模块
+		VersionReport *Version // 发现版本
+		VersionTest   *Version // 验证版本
+		VersionFix    *Version // 合入版本
+		VersionClose  *Version // 关闭版本
+		Feature       *Feature // 特性
+	}
+)
+
+// SettingResolver resolves the module, version and feature fields of stories
+// and bugs, which hold a name or an ID, to their settings. The settings are
+// loaded once per workspace and cached.
+//
+// It is safe for concurrent use.
+type SettingResolver struct {
+	client *Client
+
+	mu       sync.Mutex
+	modules  map[int][]*Module
+	versions map[int][]*Version
+	features map[int][]*Feature
+}
+
+// NewSettingResolver creates a resolver using the client.
+func NewSettingResolver(client *Client) *SettingResolver {
+	return &SettingResolver{
+		client:   client,
+		modules:  make(map[int][]*Module),
+		versions: make(map[int][]*Version),
+		features: make(map[int][]*Feature),
+	}
+}
+
+// Invalidate drops the cached settings of the workspace.
+func (r *SettingResolver) Invalidate(workspaceID int) {
+	r.mu.Lock()
+	delete(r.modules, workspaceID)
+	delete(r.versions, workspaceID)
+	delete(r.features, workspaceID)
+	r.mu.Unlock()
+}
+
+// Module returns the module of the workspace with the given name or ID.
+func (r *SettingResolver) Module(
+	ctx context.Context, workspaceID int, value string, opts ...RequestOption,
+) (*Module, error) {
+	return resolveSetting(r, r.modules, workspaceID, value, "module",
+		func(module *Module) (string, string) {
+			return module.ID, module.Name
+		},
+		func(page int) ([]*Module, error) {
+			modules, _, err := r.client.SettingService.GetModules(ctx, &GetModulesRequest{
+				WorkspaceID: new(workspaceID),
+				Limit:       new(settingPageLimit),
+				Page:        new(page),
+			}, opts...)
+			return modules, err
+		},
+	)
+}
+
+// Version returns the version of the workspace with the given name or ID.
+func (r *SettingResolver) Version(
+	ctx context.Context, workspaceID int, value string, opts ...RequestOption,
+) (*Version, error) {
+	return resolveSetting(r, r.versions, workspaceID, value, "version",
+		func(version *Version) (string, string) {
+			return version.ID, version.Name
+		},
+		func(page int) ([]*Version, error) {
+			versions, _, err := r.client.SettingService.GetVersions(ctx, &GetVersionsRequest{
+				WorkspaceID: new(workspaceID),
+				Limit:       new(settingPageLimit),
+				Page:        new(page),
+			}, opts...)
+			return versions, err
+		},
+	)
+}
+
+// Feature returns the feature of the workspace with the given name or ID.
+func (r *SettingResolver) Feature(
+	ctx context.Context, workspaceID int, value string, opts ...RequestOption,
+) (*Feature, error) {
+	return resolveSetting(r, r.features, workspaceID, value, "feature",
+		func(feature *Feature) (string, string) {
+			return feature.ID, feature.Name
+		},
+		func(page int) ([]*Feature, error) {
+			features, _, err := r.client.SettingService.GetFeatures(ctx, &GetFeaturesRequest{
+				WorkspaceID: new(workspaceID),
+				Limit:       new(settingPageLimit),
+				Page:        new(page),
+			}, opts...)
+			return features, err
+		},
+	)
+}
+
+// ResolveStory resolves the module, version and feature of the story. Empty
+// fields resolve to nil.
+func (r *SettingResolver) ResolveStory(
+	ctx context.Context, story *Story, opts ...RequestOption,
+) (*StorySettings, error) {
+	workspaceID, err := strconv.Atoi(story.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("tapd: invalid workspace id [%s]", story.WorkspaceID)
+	}
+
+	settings := new(StorySettings)
+	if settings.Module, err = resolveOptional(ctx, workspaceID, story.Module, r.Module, opts); err != nil {
+		return nil, err
+	}
+	if settings.Version, err = resolveOptional(ctx, workspaceID, story.Version, r.Version, opts); err != nil {
+		return nil, err
+	}
+	if settings.Feature, err = resolveOptional(ctx, workspaceID, story.Feature, r.Feature, opts); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// ResolveBug resolves the module, versions and feature of the bug. Empty
+// fields resolve to nil.
+func (r *SettingResolver) ResolveBug(
+	ctx context.Context, bug *Bug, opts ...RequestOption,
+) (*BugSettings, error) {
+	workspaceID, err := strconv.Atoi(bug.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("tapd: invalid workspace id [%s]", bug.WorkspaceID)
+	}
+
+	settings := new(BugSettings)
+	if settings.Module, err = resolveOptional(ctx, workspaceID, bug.Module, r.Module, opts); err != nil {
+		return nil, err
+	}
+	versions := []struct {
+		value  string
+		target **Version
+	}{
+		{bug.VersionReport, &settings.VersionReport},
+		{bug.VersionTest, &settings.VersionTest},
+		{bug.VersionFix, &settings.VersionFix},
+		{bug.VersionClose, &settings.VersionClose},
+	}
+	for _, version := range versions {
+		if *version.target, err = resolveOptional(ctx, workspaceID, version.value, r.Version, opts); err != nil {
+			return nil, err
+		}
+	}
+	if settings.Feature, err = resolveOptional(ctx, workspaceID, bug.Feature, r.Feature, opts); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func resolveOptional[T any](
+	ctx context.Context, workspaceID int, value string,
+	resolve func(context.Context, int, string, ...RequestOption) (*T, error), opts []RequestOption,
+) (*T, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return resolve(ctx, workspaceID, value, opts...)
+}
+
+// resolveSetting finds the setting matching value by ID or name, loading all
+// settings of the workspace into cache on first use.
+func resolveSetting[T any](
+	r *SettingResolver, cache map[int][]*T, workspaceID int, value, kind string,
+	key func(*T) (id, name string), load func(page int) ([]*T, error),
+) (*T, error) {
+	r.mu.Lock()
+	items, ok := cache[workspaceID]
+	r.mu.Unlock()
+
+	if !ok {
+		for page := 1; ; page++ {
+			pageItems, err := load(page)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, pageItems...)
+			if len(pageItems) < settingPageLimit {
+				break
+			}
+		}
+
+		r.mu.Lock()
+		cache[workspaceID] = items
+		r.mu.Unlock()
+	}
+
+	for _, item := range items {
+		if id, name := key(item); id == value || name == value {
+			return item, nil
+		}
+	}
+	return nil, fmt.Errorf("tapd: unknown %s [%s]", kind, value)
+}
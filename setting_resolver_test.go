@@ -0,0 +1,63 @@
+package tapd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingResolver_ResolveStory(t *testing.T) {
+	var requests int
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "200", r.URL.Query().Get("limit"))
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		switch r.URL.Path {
+		case "/modules":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_modules.json"))
+		case "/versions":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_versions.json"))
+		case "/features":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/setting/get_features.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	resolver := NewSettingResolver(client)
+	settings, err := resolver.ResolveStory(ctx, &Story{
+		WorkspaceID: "11112222",
+		Module:      "支付模块",
+		Version:     "v1.1",
+		Feature:     "1111112222001000401",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000102", settings.Module.ID)
+	assert.Equal(t, "1111112222001000202", settings.Version.ID)
+	assert.Equal(t, "扫码登录", settings.Feature.Name)
+	assert.Equal(t, 3, requests)
+
+	bug, err := resolver.ResolveBug(ctx, &Bug{
+		WorkspaceID:   "11112222",
+		VersionReport: "v1.0",
+		VersionFix:    "v1.1",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, bug.Module)
+	assert.Equal(t, "v1.0", bug.VersionReport.Name)
+	assert.Equal(t, "v1.1", bug.VersionFix.Name)
+	assert.Nil(t, bug.VersionClose)
+	assert.Equal(t, 3, requests, "settings are cached per workspace")
+
+	_, err = resolver.Module(ctx, 11112222, "未知模块")
+	assert.EqualError(t, err, "tapd: unknown module [未知模块]")
+
+	resolver.Invalidate(11112222)
+	_, err = resolver.Module(ctx, 11112222, "登录模块")
+	require.NoError(t, err)
+	assert.Equal(t, 4, requests)
+}
@@ -0,0 +1,167 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+)
+
+// ErrStorageDocumentNotFound is returned when no storage document matches.
+var ErrStorageDocumentNotFound = errors.New("tapd: storage document not found")
+
+// storagePageLimit is the page size used when loading all matching documents.
+const storagePageLimit = 200
+
+// Document is a value of a Collection together with its storage metadata.
+type Document[T any] struct {
+	ID       string // 数据ID
+	Value    T      // 数据内容
+	Creator  string // 创建人
+	Created  string // 创建时间
+	Modified string // 最后修改时间
+}
+
+// Collection persists values of T as JSON documents of one public storage
+// data type in a workspace.
+type Collection[T any] struct {
+	client      *Client
+	workspaceID int
+	name        string
+}
+
+// NewCollection creates a collection storing its documents under the data type name.
+func NewCollection[T any](client *Client, workspaceID int, name string) *Collection[T] {
+	return &Collection[T]{
+		client:      client,
+		workspaceID: workspaceID,
+		name:        name,
+	}
+}
+
+// Insert saves the value as a new document.
+func (c *Collection[T]) Insert(ctx context.Context, value T, opts ...RequestOption) (*Document[T], error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	record, _, err := c.client.StorageService.SaveStorageData(ctx, &SaveStorageDataRequest{
+		WorkspaceID: new(c.workspaceID),
+		Type:        new(c.name),
+		Data:        data,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDocument[T](record)
+}
+
+// Get returns the document with the ID.
+func (c *Collection[T]) Get(ctx context.Context, id string, opts ...RequestOption) (*Document[T], error) {
+	records, _, err := c.client.StorageService.GetStorageData(ctx, &GetStorageDataRequest{
+		WorkspaceID: new(c.workspaceID),
+		Type:        new(c.name),
+		Condition:   StorageField("id").Eq(id),
+		Limit:       new(1),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrStorageDocumentNotFound
+	}
+
+	return newDocument[T](records[0])
+}
+
+// Find returns all documents matching the condition, or all documents of the
+// collection when condition is nil.
+func (c *Collection[T]) Find(
+	ctx context.Context, condition *StorageCondition, opts ...RequestOption,
+) ([]*Document[T], error) {
+	var documents []*Document[T]
+	for page := 1; ; page++ {
+		records, _, err := c.client.StorageService.GetStorageData(ctx, &GetStorageDataRequest{
+			WorkspaceID: new(c.workspaceID),
+			Type:        new(c.name),
+			Condition:   condition,
+			Limit:       new(storagePageLimit),
+			Page:        new(page),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range records {
+			document, err := newDocument[T](record)
+			if err != nil {
+				return nil, err
+			}
+			documents = append(documents, document)
+		}
+
+		if len(records) < storagePageLimit {
+			return documents, nil
+		}
+	}
+}
+
+// Update replaces the value of the documents matching the condition and
+// returns the number of updated documents. The condition is required.
+func (c *Collection[T]) Update(
+	ctx context.Context, condition *StorageCondition, value T, opts ...RequestOption,
+) (int, error) {
+	if condition.String() == "" {
+		return 0, ErrStorageConditionRequired
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return 0, err
+	}
+
+	count, _, err := c.client.StorageService.UpdateStorageData(ctx, &UpdateStorageDataRequest{
+		WorkspaceID: new(c.workspaceID),
+		Type:        new(c.name),
+		Condition:   condition,
+		Data:        data,
+	}, opts...)
+	return count, err
+}
+
+// Delete deletes the documents matching the condition and returns the number
+// of deleted documents. The condition is required.
+func (c *Collection[T]) Delete(
+	ctx context.Context, condition *StorageCondition, opts ...RequestOption,
+) (int, error) {
+	if condition.String() == "" {
+		return 0, ErrStorageConditionRequired
+	}
+
+	count, _, err := c.client.StorageService.DeleteStorageData(ctx, &DeleteStorageDataRequest{
+		WorkspaceID: new(c.workspaceID),
+		Type:        new(c.name),
+		Condition:   condition,
+	}, opts...)
+	return count, err
+}
+
+func newDocument[T any](record *StorageRecord) (*Document[T], error) {
+	if record == nil {
+		return nil, errors.New("tapd: empty storage record")
+	}
+
+	document := &Document[T]{
+		ID:       record.ID,
+		Creator:  record.Creator,
+		Created:  record.Created,
+		Modified: record.Modified,
+	}
+	if len(record.Data) > 0 {
+		if err := record.Unmarshal(&document.Value); err != nil {
+			return nil, err
+		}
+	}
+	return document, nil
+}
@@ -0,0 +1,91 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSyncState struct {
+	Cursor string `json:"cursor"`
+	Count  int    `json:"count"`
+}
+
+func TestCollection(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/storage/save":
+			var req map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "sync_state", req["type"])
+			assert.Equal(t, map[string]any{"cursor": "abc", "count": float64(3)}, req["data"])
+			_, _ = w.Write(loadData(t, "internal/testdata/api/storage/save_storage_data.json"))
+		case "/storage/query":
+			assert.Equal(t, "sync_state", r.URL.Query().Get("type"))
+			if r.URL.Query().Get("condition") == `id = "missing"` {
+				_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+				return
+			}
+			_, _ = w.Write(loadData(t, "internal/testdata/api/storage/get_storage_data.json"))
+		case "/storage/update":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/storage/update_storage_data.json"))
+		case "/storage/delete":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/storage/delete_storage_data.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	collection := NewCollection[testSyncState](client, 11112222, "sync_state")
+
+	document, err := collection.Insert(ctx, testSyncState{Cursor: "abc", Count: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "1111112222001000501", document.ID)
+	assert.Equal(t, testSyncState{Cursor: "abc", Count: 3}, document.Value)
+
+	documents, err := collection.Find(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, documents, 2)
+	assert.Equal(t, testSyncState{Cursor: "def", Count: 5}, documents[1].Value)
+
+	document, err = collection.Get(ctx, "1111112222001000501")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", document.Value.Cursor)
+
+	_, err = collection.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrStorageDocumentNotFound)
+
+	count, err := collection.Update(ctx, StorageField("cursor").Eq("abc"), testSyncState{Cursor: "xyz"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = collection.Delete(ctx, StorageField("count").Lt(10))
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestCollection_ConditionRequired(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}))
+
+	collection := NewCollection[testSyncState](client, 11112222, "sync_state")
+
+	_, err := collection.Update(ctx, StorageAnd(nil), testSyncState{Cursor: "xyz"})
+	assert.ErrorIs(t, err, ErrStorageConditionRequired)
+
+	_, err = collection.Delete(ctx, nil)
+	assert.ErrorIs(t, err, ErrStorageConditionRequired)
+}
+
+func TestCollection_EmptyRecord(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":1,"data":{},"info":"success"}`))
+	}))
+
+	_, err := NewCollection[testSyncState](client, 11112222, "sync_state").Insert(ctx, testSyncState{})
+	assert.Error(t, err)
+}
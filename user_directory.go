@@ -0,0 +1,179 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// userDirectoryBatchSize is the number of users whose third-party user IDs are
+// requested at once.
+const userDirectoryBatchSize = 100
+
+// DirectoryUser is a workspace member together with the user ID mapped in the
+// third-party system.
+type DirectoryUser struct {
+	*User
+
+	ExternalID string // 三方系统userId
+}
+
+// UserDirectory looks up workspace members by nick, email or third-party user
+// ID. The members and their third-party user IDs are loaded once per workspace
+// and cached.
+//
+// It is safe for concurrent use.
+type UserDirectory struct {
+	client   *Client
+	platform string
+
+	mu      sync.Mutex
+	indexes map[int]*userDirectoryIndex
+}
+
+type userDirectoryIndex struct {
+	users        []*DirectoryUser
+	byNick       map[string]*DirectoryUser
+	byEmail      map[string]*DirectoryUser
+	byExternalID map[string]*DirectoryUser
+}
+
+// NewUserDirectory creates a directory using the client. The platform selects
+// the third-party system whose user IDs are mapped, empty for the default one.
+func NewUserDirectory(client *Client, platform string) *UserDirectory {
+	return &UserDirectory{
+		client:   client,
+		platform: platform,
+		indexes:  make(map[int]*userDirectoryIndex),
+	}
+}
+
+// Invalidate drops the cached members of the workspace.
+func (d *UserDirectory) Invalidate(workspaceID int) {
+	d.mu.Lock()
+	delete(d.indexes, workspaceID)
+	d.mu.Unlock()
+}
+
+// ByNick returns the member of the workspace with the nick.
+func (d *UserDirectory) ByNick(
+	ctx context.Context, workspaceID int, nick string, opts ...RequestOption,
+) (*DirectoryUser, error) {
+	index, err := d.index(ctx, workspaceID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if user, ok := index.byNick[nick]; ok {
+		return user, nil
+	}
+	return nil, fmt.Errorf("tapd: unknown user [%s]", nick)
+}
+
+// ByEmail returns the member of the workspace with the email, compared case
+// insensitively.
+func (d *UserDirectory) ByEmail(
+	ctx context.Context, workspaceID int, email string, opts ...RequestOption,
+) (*DirectoryUser, error) {
+	index, err := d.index(ctx, workspaceID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if user, ok := index.byEmail[strings.ToLower(email)]; ok {
+		return user, nil
+	}
+	return nil, fmt.Errorf("tapd: unknown user email [%s]", email)
+}
+
+// ByExternalID returns the member of the workspace mapped to the third-party
+// user ID.
+func (d *UserDirectory) ByExternalID(
+	ctx context.Context, workspaceID int, externalID string, opts ...RequestOption,
+) (*DirectoryUser, error) {
+	index, err := d.index(ctx, workspaceID, opts)
+	if err != nil {
+		return nil, err
+	}
+	if user, ok := index.byExternalID[externalID]; ok {
+		return user, nil
+	}
+	return nil, fmt.Errorf("tapd: unknown user external id [%s]", externalID)
+}
+
+// Users returns all members of the workspace in the order of the API.
+func (d *UserDirectory) Users(
+	ctx context.Context, workspaceID int, opts ...RequestOption,
+) ([]*DirectoryUser, error) {
+	index, err := d.index(ctx, workspaceID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(index.users), nil
+}
+
+// index returns the index of the workspace, loading it into cache on first use.
+func (d *UserDirectory) index(
+	ctx context.Context, workspaceID int, opts []RequestOption,
+) (*userDirectoryIndex, error) {
+	d.mu.Lock()
+	index, ok := d.indexes[workspaceID]
+	d.mu.Unlock()
+	if ok {
+		return index, nil
+	}
+
+	users, _, err := d.client.WorkspaceService.GetUsers(ctx, &GetUsersRequest{
+		WorkspaceID: new(workspaceID),
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	index = &userDirectoryIndex{
+		users:        make([]*DirectoryUser, 0, len(users)),
+		byNick:       make(map[string]*DirectoryUser, len(users)),
+		byEmail:      make(map[string]*DirectoryUser, len(users)),
+		byExternalID: make(map[string]*DirectoryUser, len(users)),
+	}
+	nicks := make([]string, 0, len(users))
+	for _, user := range users {
+		entry := &DirectoryUser{User: user}
+		index.users = append(index.users, entry)
+		index.byNick[user.User] = entry
+		if user.Email != "" {
+			index.byEmail[strings.ToLower(user.Email)] = entry
+		}
+		nicks = append(nicks, user.User)
+	}
+
+	var platform *string
+	if d.platform != "" {
+		platform = new(d.platform)
+	}
+	for start := 0; start < len(nicks); start += userDirectoryBatchSize {
+		end := min(start+userDirectoryBatchSize, len(nicks))
+		ids, _, err := d.client.UserService.GetThirdPartyUserIDs(ctx, &GetThirdPartyUserIDsRequest{
+			User:     NewMulti(nicks[start:end]...),
+			Platform: platform,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			entry, ok := index.byNick[id.User]
+			if !ok || id.UserID == "" {
+				continue
+			}
+			entry.ExternalID = id.UserID
+			index.byExternalID[id.UserID] = entry
+		}
+	}
+
+	d.mu.Lock()
+	d.indexes[workspaceID] = index
+	d.mu.Unlock()
+
+	return index, nil
+}
@@ -0,0 +1,104 @@
+package tapd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDirectory(t *testing.T) {
+	var requests int
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/workspaces/users":
+			assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/users.json"))
+		case "/users/third_party_user_ids":
+			assert.Equal(t, "张三,李四", r.URL.Query().Get("user"))
+			assert.Equal(t, "wework", r.URL.Query().Get("platform"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/user/get_third_party_user_ids.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	directory := NewUserDirectory(client, "wework")
+
+	user, err := directory.ByExternalID(ctx, 11112222, "lisi")
+	require.NoError(t, err)
+	assert.Equal(t, "李四", user.User.User)
+	assert.Equal(t, []string{"11111122222001000028", "11111122222001000143"}, user.RoleID)
+
+	user, err = directory.ByNick(ctx, 11112222, "张三")
+	require.NoError(t, err)
+	assert.Equal(t, "zhangsan", user.ExternalID)
+
+	_, err = directory.ByEmail(ctx, 11112222, "zhangsan@example.com")
+	assert.EqualError(t, err, "tapd: unknown user email [zhangsan@example.com]")
+
+	users, err := directory.Users(ctx, 11112222)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, "张三", users[0].Name)
+	assert.Equal(t, 2, requests, "members are cached per workspace")
+
+	_, err = directory.ByExternalID(ctx, 11112222, "wangwu")
+	assert.EqualError(t, err, "tapd: unknown user external id [wangwu]")
+
+	directory.Invalidate(11112222)
+	_, err = directory.ByNick(ctx, 11112222, "张三")
+	require.NoError(t, err)
+	assert.Equal(t, 4, requests)
+}
+
+func TestUserDirectory_ByEmail(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workspaces/users":
+			_, _ = w.Write([]byte(`{"status":1,"data":[
+				{"UserWorkspace":{"user":"张三","name":"张三","email":"ZhangSan@example.com"}},
+				{"UserWorkspace":{"user":"王五","name":"王五"}}
+			],"info":"success"}`))
+		case "/users/third_party_user_ids":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/user/get_third_party_user_ids.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	directory := NewUserDirectory(client, "")
+
+	user, err := directory.ByEmail(ctx, 11112222, "zhangsan@EXAMPLE.com")
+	require.NoError(t, err)
+	assert.Equal(t, "张三", user.Name)
+	assert.Equal(t, "zhangsan", user.ExternalID)
+
+	user, err = directory.ByNick(ctx, 11112222, "王五")
+	require.NoError(t, err)
+	assert.Empty(t, user.ExternalID)
+}
+
+func TestUserDirectory_NoExternalIDs(t *testing.T) {
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/workspaces/users":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workspace/users.json"))
+		case "/users/third_party_user_ids":
+			_, _ = w.Write([]byte(`{"status":1,"data":[],"info":"success"}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	directory := NewUserDirectory(client, "wework")
+
+	user, err := directory.ByNick(ctx, 11112222, "张三")
+	require.NoError(t, err)
+	assert.Empty(t, user.ExternalID)
+
+	_, err = directory.ByExternalID(ctx, 11112222, "zhangsan")
+	assert.EqualError(t, err, "tapd: unknown user external id [zhangsan]")
+}
@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-tapd/tapd"
+)
+
+// FieldChange is a field changed by an update event.
+type FieldChange struct {
+	Field string // 字段标识，如 status、custom_field_17
+	Old   string // 变更前的值
+	New   string // 变更后的值
+}
+
+// Label returns the label of the field in labels, keyed by field identifier,
+// or the field identifier itself when missing. The labels can be built from
+// GetStoryFieldsLabel, GetBugFieldsLabel or the custom field settings.
+func (c FieldChange) Label(labels map[string]string) string {
+	if label, ok := labels[c.Field]; ok && label != "" {
+		return label
+	}
+	return c.Field
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *StoryUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *StoryUpdateEvent) StatusChanged() (from, to tapd.StoryStatus, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// OwnerChanged reports whether the owner changed, and from and to which owner.
+func (e *StoryUpdateEvent) OwnerChanged() (from, to string, ok bool) {
+	return e.OldOwner, e.NewOwner, hasChangeField(e.ChangeFields, "owner")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *BugUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *BugUpdateEvent) StatusChanged() (from, to string, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// OwnerChanged reports whether the current owner changed, and from and to
+// which owner.
+func (e *BugUpdateEvent) OwnerChanged() (from, to string, ok bool) {
+	return e.OldCurrentOwner, e.NewCurrentOwner, hasChangeField(e.ChangeFields, "current_owner")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *TaskUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *TaskUpdateEvent) StatusChanged() (from, to tapd.TaskStatus, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// OwnerChanged reports whether the owner changed, and from and to which owner.
+func (e *TaskUpdateEvent) OwnerChanged() (from, to string, ok bool) {
+	return e.OldOwner, e.NewOwner, hasChangeField(e.ChangeFields, "owner")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *IterationUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *IterationUpdateEvent) StatusChanged() (from, to string, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values.
+func (e *ReleaseUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, nil, nil)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *ReleaseUpdateEvent) StatusChanged() (from, to string, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values.
+func (e *LaunchFormUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, nil, nil)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *LaunchFormUpdateEvent) StatusChanged() (from, to string, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *TestCaseUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *TestCaseUpdateEvent) StatusChanged() (from, to tapd.TestCaseStatus, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values, custom fields included.
+func (e *TestPlanUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, e.OldCustomFields, e.NewCustomFields)
+}
+
+// StatusChanged reports whether the status changed, and from and to which
+// status.
+func (e *TestPlanUpdateEvent) StatusChanged() (from, to string, ok bool) {
+	return e.OldStatus, e.NewStatus, hasChangeField(e.ChangeFields, "status")
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values.
+func (e *WikiUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, nil, nil)
+}
+
+// Changes returns the fields listed in change_fields with their old and new
+// values.
+func (e *TimesheetUpdateEvent) Changes() []FieldChange {
+	return fieldChanges(e, e.ChangeFields, nil, nil)
+}
+
+// splitChangeFields returns the distinct fields of change_fields in order.
+func splitChangeFields(changeFields string) []string {
+	var fields []string
+	for field := range strings.SplitSeq(changeFields, ",") {
+		field = strings.TrimSpace(field)
+		if field != "" && !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func hasChangeField(changeFields, field string) bool {
+	return slices.Contains(splitChangeFields(changeFields), field)
+}
+
+// fieldChanges reads the old_ and new_ values of the changed fields from the
+// event struct, or from the custom fields for custom field identifiers.
+func fieldChanges(event any, changeFields string, oldFields, newFields tapd.CustomFields) []FieldChange {
+	fields := splitChangeFields(changeFields)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	v := reflect.ValueOf(event).Elem()
+	index := eventFieldIndex(v.Type())
+	changes := make([]FieldChange, 0, len(fields))
+	for _, field := range fields {
+		change := FieldChange{Field: field}
+		if tapd.IsCustomField(field) {
+			change.Old, change.New = oldFields[field], newFields[field]
+		} else {
+			if i, ok := index["old_"+field]; ok {
+				change.Old = stringifyField(v.Field(i))
+			}
+			if i, ok := index["new_"+field]; ok {
+				change.New = stringifyField(v.Field(i))
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+var eventFieldIndexes sync.Map // reflect.Type => map[string]int
+
+// eventFieldIndex returns the index of the struct fields by JSON name.
+func eventFieldIndex(t reflect.Type) map[string]int {
+	if index, ok := eventFieldIndexes.Load(t); ok {
+		return index.(map[string]int)
+	}
+
+	index := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			index[name] = i
+		}
+	}
+	eventFieldIndexes.Store(t, index)
+	return index
+}
+
+func stringifyField(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return v.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
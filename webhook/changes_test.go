@@ -0,0 +1,94 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/go-tapd/tapd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoryUpdateEvent_Changes(t *testing.T) {
+	var event StoryUpdateEvent
+	loadAndParseWebhookData(t, "story/update.json", &event)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "owner", Old: "old owner", New: "new owner"},
+		{Field: "modified", Old: "2024-08-26 13:02:29", New: "2024-08-27 18:07:00"},
+	}, event.Changes())
+
+	from, to, ok := event.OwnerChanged()
+	assert.True(t, ok)
+	assert.Equal(t, "old owner", from)
+	assert.Equal(t, "new owner", to)
+
+	_, _, ok = event.StatusChanged()
+	assert.False(t, ok)
+}
+
+func TestStoryUpdateEvent_Changes_CustomFields(t *testing.T) {
+	var event StoryUpdateEvent
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"change_fields": "status, custom_field_17,status,",
+		"old_status": "planning",
+		"new_status": "developing",
+		"old_custom_field_17": "a",
+		"new_custom_field_17": "b"
+	}`), &event))
+
+	changes := event.Changes()
+	assert.Equal(t, []FieldChange{
+		{Field: "status", Old: "planning", New: "developing"},
+		{Field: "custom_field_17", Old: "a", New: "b"},
+	}, changes)
+
+	labels := map[string]string{"status": "状态", "custom_field_17": "客户"}
+	assert.Equal(t, "状态", changes[0].Label(labels))
+	assert.Equal(t, "客户", changes[1].Label(labels))
+	assert.Equal(t, "status", changes[0].Label(nil))
+
+	from, to, ok := event.StatusChanged()
+	assert.True(t, ok)
+	assert.Equal(t, tapd.StoryStatusPlanning, from)
+	assert.Equal(t, tapd.StoryStatusDeveloping, to)
+
+	assert.Nil(t, (&StoryUpdateEvent{}).Changes())
+}
+
+func TestBugUpdateEvent_Changes(t *testing.T) {
+	var event BugUpdateEvent
+	loadAndParseWebhookData(t, "bug/update.json", &event)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "title", Old: "123", New: "123222"},
+		{Field: "modified", Old: "2024-12-30 18:24:53", New: "2024-12-30 18:25:09"},
+	}, event.Changes())
+
+	_, _, ok := event.StatusChanged()
+	assert.False(t, ok)
+	_, _, ok = event.OwnerChanged()
+	assert.False(t, ok)
+}
+
+func TestTaskUpdateEvent_Changes(t *testing.T) {
+	var event TaskUpdateEvent
+	loadAndParseWebhookData(t, "task/update.json", &event)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "name", Old: "test story", New: "test story13"},
+		{Field: "modified", Old: "2024-12-30 18:09:01", New: "2024-12-30 18:23:57"},
+	}, event.Changes())
+}
+
+func TestIterationUpdateEvent_Changes(t *testing.T) {
+	var event IterationUpdateEvent
+	loadAndParseWebhookData(t, "iteration/update.json", &event)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "sort", Old: "0", New: "100125000000"},
+		{Field: "ancestor_id", Old: "0", New: "111112223001002244"},
+		{Field: "path", Old: "", New: "111112223001002244:"},
+		{Field: "modified", Old: "2024-12-30 18:11:44", New: "2024-12-30 18:11:44"},
+	}, event.Changes())
+}
@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DedupStore records the IDs of the events being or already processed, so that
+// events redelivered by TAPD are processed once.
+type DedupStore interface {
+	// Reserve claims the event ID for processing. It reports false when the ID
+	// is already reserved or committed.
+	Reserve(ctx context.Context, eventID string) (bool, error)
+
+	// Commit records the reserved event ID as processed.
+	Commit(ctx context.Context, eventID string) error
+
+	// Release drops the reservation of the event ID, so that a redelivery of
+	// the event is processed again.
+	Release(ctx context.Context, eventID string) error
+}
+
+// WithDedup skips the events whose ID is already reserved or committed in
+// store. An event is committed once all its listeners succeed and released
+// when any of them fails, so that it can be retried. Events without an ID are
+// always dispatched.
+func WithDedup(store DedupStore) Option {
+	return func(d *Dispatcher) {
+		d.dedupStore = store
+	}
+}
+
+// WithDuplicateHook calls hook for every event skipped as a duplicate, e.g. to
+// count them.
+func WithDuplicateHook(hook func(ctx context.Context, eventType EventType, eventID string)) Option {
+	return func(d *Dispatcher) {
+		d.duplicateHook = hook
+	}
+}
+
+// dispatchOnce calls dispatch unless the event is a duplicate.
+func (d *Dispatcher) dispatchOnce(ctx context.Context, eventType EventType, eventID string, dispatch func() error) error {
+	if d.dedupStore == nil || eventID == "" {
+		return dispatch()
+	}
+
+	reserved, err := d.dedupStore.Reserve(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("tapd: webhook dedup reserve [%s]: %w", eventID, err)
+	}
+	if !reserved {
+		if d.duplicateHook != nil {
+			d.duplicateHook(ctx, eventType, eventID)
+		}
+		return nil
+	}
+
+	if err := dispatch(); err != nil {
+		if releaseErr := d.dedupStore.Release(context.WithoutCancel(ctx), eventID); releaseErr != nil {
+			return errors.Join(err, fmt.Errorf("tapd: webhook dedup release [%s]: %w", eventID, releaseErr))
+		}
+		return err
+	}
+
+	if err := d.dedupStore.Commit(context.WithoutCancel(ctx), eventID); err != nil {
+		// release the reservation so that the redelivery is not skipped
+		err = fmt.Errorf("tapd: webhook dedup commit [%s]: %w", eventID, err)
+		if releaseErr := d.dedupStore.Release(context.WithoutCancel(ctx), eventID); releaseErr != nil {
+			return errors.Join(err, fmt.Errorf("tapd: webhook dedup release [%s]: %w", eventID, releaseErr))
+		}
+		return err
+	}
+	return nil
+}
+
+// MemoryDedupStore is a DedupStore keeping event IDs in memory for a TTL.
+//
+// It is safe for concurrent use.
+type MemoryDedupStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]time.Time // event ID => expiration
+	nextSweep time.Time
+}
+
+var _ DedupStore = (*MemoryDedupStore)(nil)
+
+// NewMemoryDedupStore returns a store forgetting event IDs after ttl. The ttl
+// should cover the redelivery window of TAPD.
+func NewMemoryDedupStore(ttl time.Duration) *MemoryDedupStore {
+	return &MemoryDedupStore{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]time.Time),
+	}
+}
+
+func (s *MemoryDedupStore) Reserve(_ context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+	if expires, ok := s.entries[eventID]; ok && now.Before(expires) {
+		return false, nil
+	}
+	s.entries[eventID] = now.Add(s.ttl)
+	return true, nil
+}
+
+func (s *MemoryDedupStore) Commit(_ context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[eventID] = s.now().Add(s.ttl)
+	return nil
+}
+
+func (s *MemoryDedupStore) Release(_ context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, eventID)
+	return nil
+}
+
+// Len returns the number of event IDs reserved or committed and not expired.
+func (s *MemoryDedupStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(s.now())
+	return len(s.entries)
+}
+
+// restore records the event ID as committed until expires.
+func (s *MemoryDedupStore) restore(eventID string, expires time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[eventID] = expires
+}
+
+// sweep drops the expired entries, at most once per ttl.
+func (s *MemoryDedupStore) sweep(now time.Time) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+	for eventID, expires := range s.entries {
+		if !now.Before(expires) {
+			delete(s.entries, eventID)
+		}
+	}
+	s.nextSweep = now.Add(s.ttl)
+}
+
+// FileDedupStore is a DedupStore persisting the committed event IDs to a file,
+// so that they survive restarts. Reservations are kept in memory only, so an
+// event interrupted by a crash is processed again on redelivery.
+//
+// The file holds one "<expiration unix> <event ID>" line per committed event
+// and is compacted when opened. It is safe for concurrent use within a single
+// process.
+type FileDedupStore struct {
+	memory *MemoryDedupStore
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ DedupStore = (*FileDedupStore)(nil)
+
+// NewFileDedupStore opens or creates the store at path, forgetting event IDs
+// after ttl.
+func NewFileDedupStore(path string, ttl time.Duration) (*FileDedupStore, error) {
+	s := &FileDedupStore{memory: NewMemoryDedupStore(ttl)}
+	if err := s.load(path); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	s.file = file
+	return s, nil
+}
+
+// Reserve rejects event IDs that cannot be stored in the file, before any
+// listener runs.
+func (s *FileDedupStore) Reserve(ctx context.Context, eventID string) (bool, error) {
+	if err := validateFileDedupID(eventID); err != nil {
+		return false, err
+	}
+	return s.memory.Reserve(ctx, eventID)
+}
+
+func (s *FileDedupStore) Commit(ctx context.Context, eventID string) error {
+	if err := validateFileDedupID(eventID); err != nil {
+		return err
+	}
+
+	expires := s.memory.now().Add(s.memory.ttl)
+	s.mu.Lock()
+	_, err := fmt.Fprintf(s.file, "%d %s\n", expires.Unix(), eventID)
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return s.memory.Commit(ctx, eventID)
+}
+
+func (s *FileDedupStore) Release(ctx context.Context, eventID string) error {
+	return s.memory.Release(ctx, eventID)
+}
+
+// Close closes the underlying file.
+func (s *FileDedupStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// validateFileDedupID rejects event IDs breaking the "unix eventID" lines.
+func validateFileDedupID(eventID string) error {
+	if strings.ContainsAny(eventID, " \r\n") {
+		return fmt.Errorf("tapd: invalid event id [%q]", eventID)
+	}
+	return nil
+}
+
+// load restores the event IDs not expired from the file at path and rewrites
+// it without the expired ones.
+func (s *FileDedupStore) load(path string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	now := s.memory.now()
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		unix, eventID, ok := strings.Cut(scanner.Text(), " ")
+		if !ok || eventID == "" {
+			continue
+		}
+		seconds, err := strconv.ParseInt(unix, 10, 64)
+		if err != nil {
+			continue
+		}
+		expires := time.Unix(seconds, 0)
+		if !now.Before(expires) {
+			continue
+		}
+		s.memory.restore(eventID, expires)
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strings.Join(append(lines, ""), "\n")), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
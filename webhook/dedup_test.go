@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDedup(t *testing.T) {
+	var (
+		calls      int
+		fail       = true
+		duplicates []string
+	)
+	errListener := errors.New("listener failed")
+	dispatcher := NewDispatcher(
+		WithDedup(NewMemoryDedupStore(time.Hour)),
+		WithDuplicateHook(func(ctx context.Context, eventType EventType, eventID string) {
+			assert.Equal(t, EventTypeStoryCreate, eventType)
+			duplicates = append(duplicates, eventID)
+		}),
+	)
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		calls++
+		if fail {
+			return errListener
+		}
+		return nil
+	})
+	payload := loadWebhookData(t, "story/create.json")
+
+	// a failed event remains retryable
+	assert.ErrorIs(t, dispatcher.DispatchPayload(t.Context(), payload), errListener)
+	fail = false
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), payload))
+	assert.Equal(t, 2, calls)
+	assert.Empty(t, duplicates)
+
+	// a processed event is skipped
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), payload))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"1687744222"}, duplicates)
+
+	// events without an ID are always dispatched
+	require.NoError(t, dispatcher.Dispatch(t.Context(), &StoryCreateEvent{}))
+	require.NoError(t, dispatcher.Dispatch(t.Context(), &StoryCreateEvent{}))
+	assert.Equal(t, 4, calls)
+}
+
+type failingCommitStore struct {
+	*MemoryDedupStore
+	fail bool
+}
+
+func (s *failingCommitStore) Commit(ctx context.Context, eventID string) error {
+	if s.fail {
+		return errors.New("commit failed")
+	}
+	return s.MemoryDedupStore.Commit(ctx, eventID)
+}
+
+func TestWithDedup_CommitFailure(t *testing.T) {
+	var calls int
+	store := &failingCommitStore{MemoryDedupStore: NewMemoryDedupStore(time.Hour), fail: true}
+	dispatcher := NewDispatcher(WithDedup(store))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		calls++
+		return nil
+	})
+	payload := loadWebhookData(t, "story/create.json")
+
+	// the redelivery of an event whose commit failed is dispatched again
+	assert.Error(t, dispatcher.DispatchPayload(t.Context(), payload))
+	store.fail = false
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), payload))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, store.Len())
+}
+
+func TestMemoryDedupStore(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryDedupStore(time.Minute)
+	store.now = func() time.Time { return now }
+
+	ok, err := store.Reserve(t.Context(), "1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.Reserve(t.Context(), "1")
+	require.NoError(t, err)
+	assert.False(t, ok, "reserved")
+
+	require.NoError(t, store.Release(t.Context(), "1"))
+	ok, err = store.Reserve(t.Context(), "1")
+	require.NoError(t, err)
+	assert.True(t, ok, "released")
+
+	require.NoError(t, store.Commit(t.Context(), "1"))
+	ok, err = store.Reserve(t.Context(), "1")
+	require.NoError(t, err)
+	assert.False(t, ok, "committed")
+	assert.Equal(t, 1, store.Len())
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, 0, store.Len())
+	ok, err = store.Reserve(t.Context(), "1")
+	require.NoError(t, err)
+	assert.True(t, ok, "expired")
+}
+
+func TestFileDedupStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dedup.log")
+
+	store, err := NewFileDedupStore(path, time.Hour)
+	require.NoError(t, err)
+
+	for _, id := range []string{"1", "2", "3"} {
+		ok, err := store.Reserve(t.Context(), id)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+	require.NoError(t, store.Commit(t.Context(), "1"))
+	require.NoError(t, store.Commit(t.Context(), "2"))
+	assert.Error(t, store.Commit(t.Context(), "bad id"))
+	_, err = store.Reserve(t.Context(), "bad\nid")
+	assert.Error(t, err)
+	require.NoError(t, store.Close())
+
+	// an expired entry is dropped on open
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	require.NoError(t, err)
+	_, err = file.WriteString("1000 expired\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	store, err = NewFileDedupStore(path, time.Hour)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	for id, want := range map[string]bool{"1": false, "2": false, "3": true, "expired": true} {
+		ok, err := store.Reserve(t.Context(), id)
+		require.NoError(t, err)
+		assert.Equal(t, want, ok, id)
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "expired")
+}
@@ -1,49 +1,112 @@
 package webhook
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
-
-	"golang.org/x/sync/errgroup"
+	"slices"
 )
 
 // Dispatcher is a dispatcher for webhook events.
 type Dispatcher struct {
 	// 需求/任务/缺陷类
-	storyCreateListeners []StoryCreateListener
-	storyUpdateListeners []StoryUpdateListener
-	storyDeleteListeners []StoryDeleteListener
-	taskCreateListeners  []TaskCreateListener
-	taskUpdateListeners  []TaskUpdateListener
-	taskDeleteListeners  []TaskDeleteListener
-	bugCreateListeners   []BugCreateListener
-	bugUpdateListeners   []BugUpdateListener
-	bugDeleteListeners   []BugDeleteListener
+	storyCreateListeners []listenerEntry[StoryCreateListener]
+	storyUpdateListeners []listenerEntry[StoryUpdateListener]
+	storyDeleteListeners []listenerEntry[StoryDeleteListener]
+	taskCreateListeners  []listenerEntry[TaskCreateListener]
+	taskUpdateListeners  []listenerEntry[TaskUpdateListener]
+	taskDeleteListeners  []listenerEntry[TaskDeleteListener]
+	bugCreateListeners   []listenerEntry[BugCreateListener]
+	bugUpdateListeners   []listenerEntry[BugUpdateListener]
+	bugDeleteListeners   []listenerEntry[BugDeleteListener]
 
 	// 评论类：需求/任务/缺陷
-	storyCommentAddListeners    []StoryCommentAddListener
-	storyCommentUpdateListeners []StoryCommentUpdateListener
-	storyCommentDeleteListeners []StoryCommentDeleteListener
-	taskCommentAddListeners     []TaskCommentAddListener
-	taskCommentUpdateListeners  []TaskCommentUpdateListener
-	taskCommentDeleteListeners  []TaskCommentDeleteListener
-	bugCommentAddListeners      []BugCommentAddListener
-	bugCommentUpdateListeners   []BugCommentUpdateListener
-	bugCommentDeleteListeners   []BugCommentDeleteListener
+	storyCommentAddListeners    []listenerEntry[StoryCommentAddListener]
+	storyCommentUpdateListeners []listenerEntry[StoryCommentUpdateListener]
+	storyCommentDeleteListeners []listenerEntry[StoryCommentDeleteListener]
+	taskCommentAddListeners     []listenerEntry[TaskCommentAddListener]
+	taskCommentUpdateListeners  []listenerEntry[TaskCommentUpdateListener]
+	taskCommentDeleteListeners  []listenerEntry[TaskCommentDeleteListener]
+	bugCommentAddListeners      []listenerEntry[BugCommentAddListener]
+	bugCommentUpdateListeners   []listenerEntry[BugCommentUpdateListener]
+	bugCommentDeleteListeners   []listenerEntry[BugCommentDeleteListener]
 
 	// 迭代
-	iterationCreateListeners []IterationCreateListener
-	iterationUpdateListeners []IterationUpdateListener
-	iterationDeleteListeners []IterationDeleteListener
+	iterationCreateListeners []listenerEntry[IterationCreateListener]
+	iterationUpdateListeners []listenerEntry[IterationUpdateListener]
+	iterationDeleteListeners []listenerEntry[IterationDeleteListener]
+
+	// 发布计划/发布评审
+	releaseCreateListeners    []listenerEntry[ReleaseCreateListener]
+	releaseUpdateListeners    []listenerEntry[ReleaseUpdateListener]
+	releaseDeleteListeners    []listenerEntry[ReleaseDeleteListener]
+	launchFormCreateListeners []listenerEntry[LaunchFormCreateListener]
+	launchFormUpdateListeners []listenerEntry[LaunchFormUpdateListener]
+	launchFormDeleteListeners []listenerEntry[LaunchFormDeleteListener]
+
+	// 测试用例/测试计划
+	testCaseCreateListeners []listenerEntry[TestCaseCreateListener]
+	testCaseUpdateListeners []listenerEntry[TestCaseUpdateListener]
+	testCaseDeleteListeners []listenerEntry[TestCaseDeleteListener]
+	testPlanCreateListeners []listenerEntry[TestPlanCreateListener]
+	testPlanUpdateListeners []listenerEntry[TestPlanUpdateListener]
+	testPlanDeleteListeners []listenerEntry[TestPlanDeleteListener]
+
+	// Wiki
+	wikiCreateListeners []listenerEntry[WikiCreateListener]
+	wikiUpdateListeners []listenerEntry[WikiUpdateListener]
+	wikiDeleteListeners []listenerEntry[WikiDeleteListener]
+
+	// 工时
+	timesheetCreateListeners []listenerEntry[TimesheetCreateListener]
+	timesheetUpdateListeners []listenerEntry[TimesheetUpdateListener]
+	timesheetDeleteListeners []listenerEntry[TimesheetDeleteListener]
+
+	// 附件
+	attachmentAddListeners    []listenerEntry[AttachmentAddListener]
+	attachmentDeleteListeners []listenerEntry[AttachmentDeleteListener]
+
+	anyEventListeners []listenerEntry[AnyEventListener]
+
+	middlewares   []Middleware
+	strategy      Strategy
+	dedupStore    DedupStore
+	duplicateHook func(ctx context.Context, eventType EventType, eventID string)
+	parseMode     ParseMode
 }
 
 type Option func(*Dispatcher)
 
+// WithRegisters registers the listeners, see Registers. Values implementing no
+// listener interface are ignored, call Registers to have them reported.
 func WithRegisters(listeners ...any) Option {
 	return func(d *Dispatcher) {
-		d.Registers(listeners...)
+		_ = d.Registers(listeners...)
+	}
+}
+
+// WithMiddlewares applies the middlewares to every listener invocation, see Use.
+func WithMiddlewares(middlewares ...Middleware) Option {
+	return func(d *Dispatcher) {
+		d.Use(middlewares...)
+	}
+}
+
+// WithStrategy sets how the listeners of an event are run, FailFast by default.
+func WithStrategy(strategy Strategy) Option {
+	return func(d *Dispatcher) {
+		d.strategy = strategy
+	}
+}
+
+// WithParseMode sets how DispatchPayload, DispatchRequest, Handler and Queue
+// parse events of unsupported types, ParseStrict by default.
+func WithParseMode(mode ParseMode) Option {
+	return func(d *Dispatcher) {
+		d.parseMode = mode
 	}
 }
 
@@ -56,94 +119,247 @@ func NewDispatcher(opts ...Option) *Dispatcher {
 	return dispatcher
 }
 
-func (d *Dispatcher) Registers(listeners ...any) {
+// Registers registers each listener for every listener interface it
+// implements. Values implementing none of them are not registered and
+// reported in the returned error.
+func (d *Dispatcher) Registers(listeners ...any) error {
+	var errs []error
 	for _, listener := range listeners {
-		if l, ok := listener.(StoryCreateListener); ok {
-			d.RegisterStoryCreateListener(l)
+		if !d.register(listener, listenerPriority(listener)) {
+			errs = append(errs, fmt.Errorf("tapd: webhook listener %T implements no listener interface", listener))
 		}
+	}
+	return errors.Join(errs...)
+}
 
-		if l, ok := listener.(StoryUpdateListener); ok {
-			d.RegisterStoryUpdateListener(l)
+// RegistersWithPriority is like Registers but registers the listeners with the
+// priority, overriding the one reported by Prioritized.
+func (d *Dispatcher) RegistersWithPriority(priority int, listeners ...any) error {
+	var errs []error
+	for _, listener := range listeners {
+		if !d.register(listener, priority) {
+			errs = append(errs, fmt.Errorf("tapd: webhook listener %T implements no listener interface", listener))
 		}
+	}
+	return errors.Join(errs...)
+}
 
-		if l, ok := listener.(StoryDeleteListener); ok {
-			d.RegisterStoryDeleteListener(l)
-		}
+// register registers the listener with the priority for every listener
+// interface it implements and reports whether it implements any.
+func (d *Dispatcher) register(listener any, priority int) bool {
+	var registered bool
 
-		if l, ok := listener.(TaskCreateListener); ok {
-			d.RegisterTaskCreateListener(l)
-		}
+	if l, ok := listener.(StoryCreateListener); ok {
+		d.storyCreateListeners = addListener(d.storyCreateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(TaskUpdateListener); ok {
-			d.RegisterTaskUpdateListener(l)
-		}
+	if l, ok := listener.(StoryUpdateListener); ok {
+		d.storyUpdateListeners = addListener(d.storyUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(TaskDeleteListener); ok {
-			d.RegisterTaskDeleteListener(l)
-		}
+	if l, ok := listener.(StoryDeleteListener); ok {
+		d.storyDeleteListeners = addListener(d.storyDeleteListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugCreateListener); ok {
-			d.RegisterBugCreateListener(l)
-		}
+	if l, ok := listener.(TaskCreateListener); ok {
+		d.taskCreateListeners = addListener(d.taskCreateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugUpdateListener); ok {
-			d.RegisterBugUpdateListener(l)
-		}
+	if l, ok := listener.(TaskUpdateListener); ok {
+		d.taskUpdateListeners = addListener(d.taskUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugDeleteListener); ok {
-			d.RegisterBugDeleteListener(l)
-		}
+	if l, ok := listener.(TaskDeleteListener); ok {
+		d.taskDeleteListeners = addListener(d.taskDeleteListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(StoryCommentAddListener); ok {
-			d.RegisterStoryCommentAddListener(l)
-		}
+	if l, ok := listener.(BugCreateListener); ok {
+		d.bugCreateListeners = addListener(d.bugCreateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(StoryCommentUpdateListener); ok {
-			d.RegisterStoryCommentUpdateListener(l)
-		}
+	if l, ok := listener.(BugUpdateListener); ok {
+		d.bugUpdateListeners = addListener(d.bugUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(StoryCommentDeleteListener); ok {
-			d.RegisterStoryCommentDeleteListener(l)
-		}
+	if l, ok := listener.(BugDeleteListener); ok {
+		d.bugDeleteListeners = addListener(d.bugDeleteListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(TaskCommentAddListener); ok {
-			d.RegisterTaskCommentAddListener(l)
-		}
+	if l, ok := listener.(StoryCommentAddListener); ok {
+		d.storyCommentAddListeners = addListener(d.storyCommentAddListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(TaskCommentUpdateListener); ok {
-			d.RegisterTaskCommentUpdateListener(l)
-		}
+	if l, ok := listener.(StoryCommentUpdateListener); ok {
+		d.storyCommentUpdateListeners = addListener(d.storyCommentUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(TaskCommentDeleteListener); ok {
-			d.RegisterTaskCommentDeleteListener(l)
-		}
+	if l, ok := listener.(StoryCommentDeleteListener); ok {
+		d.storyCommentDeleteListeners = addListener(d.storyCommentDeleteListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugCommentAddListener); ok {
-			d.RegisterBugCommentAddListener(l)
-		}
+	if l, ok := listener.(TaskCommentAddListener); ok {
+		d.taskCommentAddListeners = addListener(d.taskCommentAddListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugCommentUpdateListener); ok {
-			d.RegisterBugCommentUpdateListener(l)
-		}
+	if l, ok := listener.(TaskCommentUpdateListener); ok {
+		d.taskCommentUpdateListeners = addListener(d.taskCommentUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(BugCommentDeleteListener); ok {
-			d.RegisterBugCommentDeleteListener(l)
-		}
+	if l, ok := listener.(TaskCommentDeleteListener); ok {
+		d.taskCommentDeleteListeners = addListener(d.taskCommentDeleteListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(IterationCreateListener); ok {
-			d.RegisterIterationCreateListener(l)
-		}
+	if l, ok := listener.(BugCommentAddListener); ok {
+		d.bugCommentAddListeners = addListener(d.bugCommentAddListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(IterationUpdateListener); ok {
-			d.RegisterIterationUpdateListener(l)
-		}
+	if l, ok := listener.(BugCommentUpdateListener); ok {
+		d.bugCommentUpdateListeners = addListener(d.bugCommentUpdateListeners, l, priority)
+		registered = true
+	}
 
-		if l, ok := listener.(IterationDeleteListener); ok {
-			d.RegisterIterationDeleteListener(l)
-		}
+	if l, ok := listener.(BugCommentDeleteListener); ok {
+		d.bugCommentDeleteListeners = addListener(d.bugCommentDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(IterationCreateListener); ok {
+		d.iterationCreateListeners = addListener(d.iterationCreateListeners, l, priority)
+		registered = true
+	}
 
-		// todo: add other listeners
+	if l, ok := listener.(IterationUpdateListener); ok {
+		d.iterationUpdateListeners = addListener(d.iterationUpdateListeners, l, priority)
+		registered = true
 	}
+
+	if l, ok := listener.(IterationDeleteListener); ok {
+		d.iterationDeleteListeners = addListener(d.iterationDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(ReleaseCreateListener); ok {
+		d.releaseCreateListeners = addListener(d.releaseCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(ReleaseUpdateListener); ok {
+		d.releaseUpdateListeners = addListener(d.releaseUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(ReleaseDeleteListener); ok {
+		d.releaseDeleteListeners = addListener(d.releaseDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(LaunchFormCreateListener); ok {
+		d.launchFormCreateListeners = addListener(d.launchFormCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(LaunchFormUpdateListener); ok {
+		d.launchFormUpdateListeners = addListener(d.launchFormUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(LaunchFormDeleteListener); ok {
+		d.launchFormDeleteListeners = addListener(d.launchFormDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestCaseCreateListener); ok {
+		d.testCaseCreateListeners = addListener(d.testCaseCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestCaseUpdateListener); ok {
+		d.testCaseUpdateListeners = addListener(d.testCaseUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestCaseDeleteListener); ok {
+		d.testCaseDeleteListeners = addListener(d.testCaseDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestPlanCreateListener); ok {
+		d.testPlanCreateListeners = addListener(d.testPlanCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestPlanUpdateListener); ok {
+		d.testPlanUpdateListeners = addListener(d.testPlanUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TestPlanDeleteListener); ok {
+		d.testPlanDeleteListeners = addListener(d.testPlanDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(WikiCreateListener); ok {
+		d.wikiCreateListeners = addListener(d.wikiCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(WikiUpdateListener); ok {
+		d.wikiUpdateListeners = addListener(d.wikiUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(WikiDeleteListener); ok {
+		d.wikiDeleteListeners = addListener(d.wikiDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TimesheetCreateListener); ok {
+		d.timesheetCreateListeners = addListener(d.timesheetCreateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TimesheetUpdateListener); ok {
+		d.timesheetUpdateListeners = addListener(d.timesheetUpdateListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(TimesheetDeleteListener); ok {
+		d.timesheetDeleteListeners = addListener(d.timesheetDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(AttachmentAddListener); ok {
+		d.attachmentAddListeners = addListener(d.attachmentAddListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(AttachmentDeleteListener); ok {
+		d.attachmentDeleteListeners = addListener(d.attachmentDeleteListeners, l, priority)
+		registered = true
+	}
+
+	if l, ok := listener.(AnyEventListener); ok {
+		d.anyEventListeners = addListener(d.anyEventListeners, l, priority)
+		registered = true
+	}
+
+	return registered
 }
 
 func (d *Dispatcher) Dispatch(ctx context.Context, event any) error {
@@ -190,13 +406,55 @@ func (d *Dispatcher) Dispatch(ctx context.Context, event any) error {
 		return d.processIterationUpdate(ctx, e)
 	case *IterationDeleteEvent:
 		return d.processIterationDelete(ctx, e)
+	case *ReleaseCreateEvent:
+		return d.processReleaseCreate(ctx, e)
+	case *ReleaseUpdateEvent:
+		return d.processReleaseUpdate(ctx, e)
+	case *ReleaseDeleteEvent:
+		return d.processReleaseDelete(ctx, e)
+	case *LaunchFormCreateEvent:
+		return d.processLaunchFormCreate(ctx, e)
+	case *LaunchFormUpdateEvent:
+		return d.processLaunchFormUpdate(ctx, e)
+	case *LaunchFormDeleteEvent:
+		return d.processLaunchFormDelete(ctx, e)
+	case *TestCaseCreateEvent:
+		return d.processTestCaseCreate(ctx, e)
+	case *TestCaseUpdateEvent:
+		return d.processTestCaseUpdate(ctx, e)
+	case *TestCaseDeleteEvent:
+		return d.processTestCaseDelete(ctx, e)
+	case *TestPlanCreateEvent:
+		return d.processTestPlanCreate(ctx, e)
+	case *TestPlanUpdateEvent:
+		return d.processTestPlanUpdate(ctx, e)
+	case *TestPlanDeleteEvent:
+		return d.processTestPlanDelete(ctx, e)
+	case *WikiCreateEvent:
+		return d.processWikiCreate(ctx, e)
+	case *WikiUpdateEvent:
+		return d.processWikiUpdate(ctx, e)
+	case *WikiDeleteEvent:
+		return d.processWikiDelete(ctx, e)
+	case *TimesheetCreateEvent:
+		return d.processTimesheetCreate(ctx, e)
+	case *TimesheetUpdateEvent:
+		return d.processTimesheetUpdate(ctx, e)
+	case *TimesheetDeleteEvent:
+		return d.processTimesheetDelete(ctx, e)
+	case *AttachmentAddEvent:
+		return d.processAttachmentAdd(ctx, e)
+	case *AttachmentDeleteEvent:
+		return d.processAttachmentDelete(ctx, e)
+	case *RawEvent:
+		return d.processRaw(ctx, e)
 	default:
-		return errors.New("tapd: webhook dispatcher unsupported event")
+		return fmt.Errorf("%w: %T", ErrUnsupportedEvent, event)
 	}
 }
 
 func (d *Dispatcher) DispatchPayload(ctx context.Context, payload []byte) error {
-	_, event, err := ParseWebhookEvent(payload)
+	_, event, err := d.parse(payload)
 	if err != nil {
 		return err
 	}
@@ -231,295 +489,391 @@ func (d *Dispatcher) DispatchRequest(req *http.Request, opts ...DispatchRequestO
 }
 
 func (d *Dispatcher) RegisterStoryCreateListener(listeners ...StoryCreateListener) {
-	d.storyCreateListeners = append(d.storyCreateListeners, listeners...)
+	d.storyCreateListeners = addListeners(d.storyCreateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterStoryUpdateListener(listeners ...StoryUpdateListener) {
-	d.storyUpdateListeners = append(d.storyUpdateListeners, listeners...)
+	d.storyUpdateListeners = addListeners(d.storyUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterStoryDeleteListener(listeners ...StoryDeleteListener) {
-	d.storyDeleteListeners = append(d.storyDeleteListeners, listeners...)
+	d.storyDeleteListeners = addListeners(d.storyDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskCreateListener(listeners ...TaskCreateListener) {
-	d.taskCreateListeners = append(d.taskCreateListeners, listeners...)
+	d.taskCreateListeners = addListeners(d.taskCreateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskUpdateListener(listeners ...TaskUpdateListener) {
-	d.taskUpdateListeners = append(d.taskUpdateListeners, listeners...)
+	d.taskUpdateListeners = addListeners(d.taskUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskDeleteListener(listeners ...TaskDeleteListener) {
-	d.taskDeleteListeners = append(d.taskDeleteListeners, listeners...)
+	d.taskDeleteListeners = addListeners(d.taskDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugCreateListener(listeners ...BugCreateListener) {
-	d.bugCreateListeners = append(d.bugCreateListeners, listeners...)
+	d.bugCreateListeners = addListeners(d.bugCreateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugUpdateListener(listeners ...BugUpdateListener) {
-	d.bugUpdateListeners = append(d.bugUpdateListeners, listeners...)
+	d.bugUpdateListeners = addListeners(d.bugUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugDeleteListener(listeners ...BugDeleteListener) {
-	d.bugDeleteListeners = append(d.bugDeleteListeners, listeners...)
+	d.bugDeleteListeners = addListeners(d.bugDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterStoryCommentAddListener(listeners ...StoryCommentAddListener) {
-	d.storyCommentAddListeners = append(d.storyCommentAddListeners, listeners...)
+	d.storyCommentAddListeners = addListeners(d.storyCommentAddListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterStoryCommentUpdateListener(listeners ...StoryCommentUpdateListener) {
-	d.storyCommentUpdateListeners = append(d.storyCommentUpdateListeners, listeners...)
+	d.storyCommentUpdateListeners = addListeners(d.storyCommentUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterStoryCommentDeleteListener(listeners ...StoryCommentDeleteListener) {
-	d.storyCommentDeleteListeners = append(d.storyCommentDeleteListeners, listeners...)
+	d.storyCommentDeleteListeners = addListeners(d.storyCommentDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskCommentAddListener(listeners ...TaskCommentAddListener) {
-	d.taskCommentAddListeners = append(d.taskCommentAddListeners, listeners...)
+	d.taskCommentAddListeners = addListeners(d.taskCommentAddListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskCommentUpdateListener(listeners ...TaskCommentUpdateListener) {
-	d.taskCommentUpdateListeners = append(d.taskCommentUpdateListeners, listeners...)
+	d.taskCommentUpdateListeners = addListeners(d.taskCommentUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterTaskCommentDeleteListener(listeners ...TaskCommentDeleteListener) {
-	d.taskCommentDeleteListeners = append(d.taskCommentDeleteListeners, listeners...)
+	d.taskCommentDeleteListeners = addListeners(d.taskCommentDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugCommentAddListener(listeners ...BugCommentAddListener) {
-	d.bugCommentAddListeners = append(d.bugCommentAddListeners, listeners...)
+	d.bugCommentAddListeners = addListeners(d.bugCommentAddListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugCommentUpdateListener(listeners ...BugCommentUpdateListener) {
-	d.bugCommentUpdateListeners = append(d.bugCommentUpdateListeners, listeners...)
+	d.bugCommentUpdateListeners = addListeners(d.bugCommentUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterBugCommentDeleteListener(listeners ...BugCommentDeleteListener) {
-	d.bugCommentDeleteListeners = append(d.bugCommentDeleteListeners, listeners...)
+	d.bugCommentDeleteListeners = addListeners(d.bugCommentDeleteListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterIterationCreateListener(listeners ...IterationCreateListener) {
-	d.iterationCreateListeners = append(d.iterationCreateListeners, listeners...)
+	d.iterationCreateListeners = addListeners(d.iterationCreateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterIterationUpdateListener(listeners ...IterationUpdateListener) {
-	d.iterationUpdateListeners = append(d.iterationUpdateListeners, listeners...)
+	d.iterationUpdateListeners = addListeners(d.iterationUpdateListeners, listeners...)
 }
 
 func (d *Dispatcher) RegisterIterationDeleteListener(listeners ...IterationDeleteListener) {
-	d.iterationDeleteListeners = append(d.iterationDeleteListeners, listeners...)
+	d.iterationDeleteListeners = addListeners(d.iterationDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterReleaseCreateListener(listeners ...ReleaseCreateListener) {
+	d.releaseCreateListeners = addListeners(d.releaseCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterReleaseUpdateListener(listeners ...ReleaseUpdateListener) {
+	d.releaseUpdateListeners = addListeners(d.releaseUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterReleaseDeleteListener(listeners ...ReleaseDeleteListener) {
+	d.releaseDeleteListeners = addListeners(d.releaseDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterLaunchFormCreateListener(listeners ...LaunchFormCreateListener) {
+	d.launchFormCreateListeners = addListeners(d.launchFormCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterLaunchFormUpdateListener(listeners ...LaunchFormUpdateListener) {
+	d.launchFormUpdateListeners = addListeners(d.launchFormUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterLaunchFormDeleteListener(listeners ...LaunchFormDeleteListener) {
+	d.launchFormDeleteListeners = addListeners(d.launchFormDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestCaseCreateListener(listeners ...TestCaseCreateListener) {
+	d.testCaseCreateListeners = addListeners(d.testCaseCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestCaseUpdateListener(listeners ...TestCaseUpdateListener) {
+	d.testCaseUpdateListeners = addListeners(d.testCaseUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestCaseDeleteListener(listeners ...TestCaseDeleteListener) {
+	d.testCaseDeleteListeners = addListeners(d.testCaseDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestPlanCreateListener(listeners ...TestPlanCreateListener) {
+	d.testPlanCreateListeners = addListeners(d.testPlanCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestPlanUpdateListener(listeners ...TestPlanUpdateListener) {
+	d.testPlanUpdateListeners = addListeners(d.testPlanUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTestPlanDeleteListener(listeners ...TestPlanDeleteListener) {
+	d.testPlanDeleteListeners = addListeners(d.testPlanDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterWikiCreateListener(listeners ...WikiCreateListener) {
+	d.wikiCreateListeners = addListeners(d.wikiCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterWikiUpdateListener(listeners ...WikiUpdateListener) {
+	d.wikiUpdateListeners = addListeners(d.wikiUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterWikiDeleteListener(listeners ...WikiDeleteListener) {
+	d.wikiDeleteListeners = addListeners(d.wikiDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTimesheetCreateListener(listeners ...TimesheetCreateListener) {
+	d.timesheetCreateListeners = addListeners(d.timesheetCreateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTimesheetUpdateListener(listeners ...TimesheetUpdateListener) {
+	d.timesheetUpdateListeners = addListeners(d.timesheetUpdateListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterTimesheetDeleteListener(listeners ...TimesheetDeleteListener) {
+	d.timesheetDeleteListeners = addListeners(d.timesheetDeleteListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterAttachmentAddListener(listeners ...AttachmentAddListener) {
+	d.attachmentAddListeners = addListeners(d.attachmentAddListeners, listeners...)
+}
+
+func (d *Dispatcher) RegisterAttachmentDeleteListener(listeners ...AttachmentDeleteListener) {
+	d.attachmentDeleteListeners = addListeners(d.attachmentDeleteListeners, listeners...)
+}
+
+// RegisterAnyEventListener registers listeners receiving every event.
+func (d *Dispatcher) RegisterAnyEventListener(listeners ...AnyEventListener) {
+	d.anyEventListeners = addListeners(d.anyEventListeners, listeners...)
 }
 
 func (d *Dispatcher) processStoryCreate(ctx context.Context, event *StoryCreateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyCreateListeners {
-		eg.Go(func() error {
-			return listener.OnStoryCreate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.storyCreateListeners, StoryCreateListener.OnStoryCreate)
 }
 
 func (d *Dispatcher) processStoryUpdate(ctx context.Context, event *StoryUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnStoryUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.storyUpdateListeners, StoryUpdateListener.OnStoryUpdate)
 }
 
 func (d *Dispatcher) processStoryDelete(ctx context.Context, event *StoryDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnStoryDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.storyDeleteListeners, StoryDeleteListener.OnStoryDelete)
 }
 
 func (d *Dispatcher) processTaskCreate(ctx context.Context, event *TaskCreateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskCreateListeners {
-		eg.Go(func() error {
-			return listener.OnTaskCreate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.taskCreateListeners, TaskCreateListener.OnTaskCreate)
 }
 
 func (d *Dispatcher) processTaskUpdate(ctx context.Context, event *TaskUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnTaskUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.taskUpdateListeners, TaskUpdateListener.OnTaskUpdate)
 }
 
 func (d *Dispatcher) processTaskDelete(ctx context.Context, event *TaskDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnTaskDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.taskDeleteListeners, TaskDeleteListener.OnTaskDelete)
 }
 
 func (d *Dispatcher) processBugCreate(ctx context.Context, event *BugCreateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugCreateListeners {
-		eg.Go(func() error {
-			return listener.OnBugCreate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.bugCreateListeners, BugCreateListener.OnBugCreate)
 }
 
 func (d *Dispatcher) processBugUpdate(ctx context.Context, event *BugUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnBugUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.bugUpdateListeners, BugUpdateListener.OnBugUpdate)
 }
 
 func (d *Dispatcher) processBugDelete(ctx context.Context, event *BugDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnBugDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.bugDeleteListeners, BugDeleteListener.OnBugDelete)
 }
 
 func (d *Dispatcher) processStoryCommentAdd(ctx context.Context, event *StoryCommentAddEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyCommentAddListeners {
-		eg.Go(func() error {
-			return listener.OnStoryCommentAdd(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryCommentAdd, EventID: event.EventID, ObjectID: event.ID}, event, d.storyCommentAddListeners, StoryCommentAddListener.OnStoryCommentAdd)
 }
 
 func (d *Dispatcher) processStoryCommentUpdate(ctx context.Context, event *StoryCommentUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyCommentUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnStoryCommentUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryCommentUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.storyCommentUpdateListeners, StoryCommentUpdateListener.OnStoryCommentUpdate)
 }
 
 func (d *Dispatcher) processStoryCommentDelete(ctx context.Context, event *StoryCommentDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.storyCommentDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnStoryCommentDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeStoryCommentDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.storyCommentDeleteListeners, StoryCommentDeleteListener.OnStoryCommentDelete)
 }
 
 func (d *Dispatcher) processTaskCommentAdd(ctx context.Context, event *TaskCommentAddEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskCommentAddListeners {
-		eg.Go(func() error {
-			return listener.OnTaskCommentAdd(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskCommentAdd, EventID: event.EventID, ObjectID: event.ID}, event, d.taskCommentAddListeners, TaskCommentAddListener.OnTaskCommentAdd)
 }
 
 func (d *Dispatcher) processTaskCommentUpdate(ctx context.Context, event *TaskCommentUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskCommentUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnTaskCommentUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskCommentUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.taskCommentUpdateListeners, TaskCommentUpdateListener.OnTaskCommentUpdate)
 }
 
 func (d *Dispatcher) processTaskCommentDelete(ctx context.Context, event *TaskCommentDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.taskCommentDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnTaskCommentDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTaskCommentDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.taskCommentDeleteListeners, TaskCommentDeleteListener.OnTaskCommentDelete)
 }
 
 func (d *Dispatcher) processBugCommentAdd(ctx context.Context, event *BugCommentAddEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugCommentAddListeners {
-		eg.Go(func() error {
-			return listener.OnBugCommentAdd(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugCommentAdd, EventID: event.EventID, ObjectID: event.ID}, event, d.bugCommentAddListeners, BugCommentAddListener.OnBugCommentAdd)
 }
 
 func (d *Dispatcher) processBugCommentUpdate(ctx context.Context, event *BugCommentUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugCommentUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnBugCommentUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugCommentUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.bugCommentUpdateListeners, BugCommentUpdateListener.OnBugCommentUpdate)
 }
 
 func (d *Dispatcher) processBugCommentDelete(ctx context.Context, event *BugCommentDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.bugCommentDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnBugCommentDelete(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeBugCommentDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.bugCommentDeleteListeners, BugCommentDeleteListener.OnBugCommentDelete)
 }
 
 func (d *Dispatcher) processIterationCreate(ctx context.Context, event *IterationCreateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.iterationCreateListeners {
-		eg.Go(func() error {
-			return listener.OnIterationCreate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeIterationCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.iterationCreateListeners, IterationCreateListener.OnIterationCreate)
 }
 
 func (d *Dispatcher) processIterationUpdate(ctx context.Context, event *IterationUpdateEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.iterationUpdateListeners {
-		eg.Go(func() error {
-			return listener.OnIterationUpdate(ctx, event)
-		})
-	}
-	return eg.Wait()
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeIterationUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.iterationUpdateListeners, IterationUpdateListener.OnIterationUpdate)
 }
 
 func (d *Dispatcher) processIterationDelete(ctx context.Context, event *IterationDeleteEvent) error {
-	eg, ctx := errgroup.WithContext(ctx)
-	for _, listener := range d.iterationDeleteListeners {
-		eg.Go(func() error {
-			return listener.OnIterationDelete(ctx, event)
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeIterationDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.iterationDeleteListeners, IterationDeleteListener.OnIterationDelete)
+}
+
+func (d *Dispatcher) processReleaseCreate(ctx context.Context, event *ReleaseCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeReleaseCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.releaseCreateListeners, ReleaseCreateListener.OnReleaseCreate)
+}
+
+func (d *Dispatcher) processReleaseUpdate(ctx context.Context, event *ReleaseUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeReleaseUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.releaseUpdateListeners, ReleaseUpdateListener.OnReleaseUpdate)
+}
+
+func (d *Dispatcher) processReleaseDelete(ctx context.Context, event *ReleaseDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeReleaseDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.releaseDeleteListeners, ReleaseDeleteListener.OnReleaseDelete)
+}
+
+func (d *Dispatcher) processLaunchFormCreate(ctx context.Context, event *LaunchFormCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeLaunchFormCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.launchFormCreateListeners, LaunchFormCreateListener.OnLaunchFormCreate)
+}
+
+func (d *Dispatcher) processLaunchFormUpdate(ctx context.Context, event *LaunchFormUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeLaunchFormUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.launchFormUpdateListeners, LaunchFormUpdateListener.OnLaunchFormUpdate)
+}
+
+func (d *Dispatcher) processLaunchFormDelete(ctx context.Context, event *LaunchFormDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeLaunchFormDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.launchFormDeleteListeners, LaunchFormDeleteListener.OnLaunchFormDelete)
+}
+
+func (d *Dispatcher) processTestCaseCreate(ctx context.Context, event *TestCaseCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestCaseCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.testCaseCreateListeners, TestCaseCreateListener.OnTestCaseCreate)
+}
+
+func (d *Dispatcher) processTestCaseUpdate(ctx context.Context, event *TestCaseUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestCaseUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.testCaseUpdateListeners, TestCaseUpdateListener.OnTestCaseUpdate)
+}
+
+func (d *Dispatcher) processTestCaseDelete(ctx context.Context, event *TestCaseDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestCaseDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.testCaseDeleteListeners, TestCaseDeleteListener.OnTestCaseDelete)
+}
+
+func (d *Dispatcher) processTestPlanCreate(ctx context.Context, event *TestPlanCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestPlanCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.testPlanCreateListeners, TestPlanCreateListener.OnTestPlanCreate)
+}
+
+func (d *Dispatcher) processTestPlanUpdate(ctx context.Context, event *TestPlanUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestPlanUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.testPlanUpdateListeners, TestPlanUpdateListener.OnTestPlanUpdate)
+}
+
+func (d *Dispatcher) processTestPlanDelete(ctx context.Context, event *TestPlanDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTestPlanDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.testPlanDeleteListeners, TestPlanDeleteListener.OnTestPlanDelete)
+}
+
+func (d *Dispatcher) processWikiCreate(ctx context.Context, event *WikiCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeWikiCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.wikiCreateListeners, WikiCreateListener.OnWikiCreate)
+}
+
+func (d *Dispatcher) processWikiUpdate(ctx context.Context, event *WikiUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeWikiUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.wikiUpdateListeners, WikiUpdateListener.OnWikiUpdate)
+}
+
+func (d *Dispatcher) processWikiDelete(ctx context.Context, event *WikiDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeWikiDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.wikiDeleteListeners, WikiDeleteListener.OnWikiDelete)
+}
+
+func (d *Dispatcher) processTimesheetCreate(ctx context.Context, event *TimesheetCreateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTimesheetCreate, EventID: event.EventID, ObjectID: event.ID}, event, d.timesheetCreateListeners, TimesheetCreateListener.OnTimesheetCreate)
+}
+
+func (d *Dispatcher) processTimesheetUpdate(ctx context.Context, event *TimesheetUpdateEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTimesheetUpdate, EventID: event.EventID, ObjectID: event.ID}, event, d.timesheetUpdateListeners, TimesheetUpdateListener.OnTimesheetUpdate)
+}
+
+func (d *Dispatcher) processTimesheetDelete(ctx context.Context, event *TimesheetDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeTimesheetDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.timesheetDeleteListeners, TimesheetDeleteListener.OnTimesheetDelete)
+}
+
+func (d *Dispatcher) processAttachmentAdd(ctx context.Context, event *AttachmentAddEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeAttachmentAdd, EventID: event.EventID, ObjectID: event.ID}, event, d.attachmentAddListeners, AttachmentAddListener.OnAttachmentAdd)
+}
+
+func (d *Dispatcher) processAttachmentDelete(ctx context.Context, event *AttachmentDeleteEvent) error {
+	return dispatchListeners(ctx, d, Invocation{EventType: EventTypeAttachmentDelete, EventID: event.EventID, ObjectID: event.ID}, event, d.attachmentDeleteListeners, AttachmentDeleteListener.OnAttachmentDelete)
+}
+
+func (d *Dispatcher) processRaw(ctx context.Context, event *RawEvent) error {
+	return dispatchListeners[AnyEventListener](ctx, d, Invocation{EventType: event.Event, EventID: event.EventID, ObjectID: event.ID}, event, nil, nil)
+}
+
+// parse parses the payload in the parse mode of the dispatcher.
+func (d *Dispatcher) parse(payload []byte) (EventType, any, error) {
+	return ParseWebhookEventWithMode(payload, d.parseMode)
+}
+
+// dispatchListeners invokes every listener with the event, followed by the
+// listeners of any event with the same priority, through the middleware chain
+// and using the strategy of the dispatcher. The invocation holds the event
+// type and IDs shared by all the listeners.
+func dispatchListeners[L any, E any](
+	ctx context.Context, d *Dispatcher, base Invocation, event E,
+	listeners []listenerEntry[L], call func(L, context.Context, E) error,
+) error {
+	type prioritizedCall struct {
+		priority int
+		call     func(ctx context.Context) error
+	}
+	calls := make([]prioritizedCall, 0, len(listeners)+len(d.anyEventListeners))
+	invoke := func(listener any, priority int, fn func(ctx context.Context) error) {
+		invocation := base
+		invocation.Event = event
+		invocation.Listener = listener
+		calls = append(calls, prioritizedCall{priority, func(ctx context.Context) error {
+			return d.invoke(ctx, &invocation, fn)
+		}})
+	}
+	for _, entry := range listeners {
+		invoke(entry.listener, entry.priority, func(ctx context.Context) error {
+			return call(entry.listener, ctx, event)
+		})
+	}
+	for _, entry := range d.anyEventListeners {
+		invoke(entry.listener, entry.priority, func(ctx context.Context) error {
+			return entry.listener.OnAnyEvent(ctx, base.EventType, event)
 		})
 	}
-	return eg.Wait()
+	slices.SortStableFunc(calls, func(a, b prioritizedCall) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	fns := make([]func(ctx context.Context) error, 0, len(calls))
+	for _, c := range calls {
+		fns = append(fns, c.call)
+	}
+
+	strategy := d.strategy
+	if strategy == nil {
+		strategy = FailFast()
+	}
+	return d.dispatchOnce(ctx, base.EventType, base.EventID, func() error {
+		return strategy(ctx, fns)
+	})
 }
@@ -73,6 +73,26 @@ func TestDispatcher_Dispatch(t *testing.T) {
 		{"iteration create", "iteration/create.json"},
 		{"iteration update", "iteration/update.json"},
 		{"iteration delete", "iteration/delete.json"},
+		{"release plan create", "release/create.json"},
+		{"release plan update", "release/update.json"},
+		{"release plan delete", "release/delete.json"},
+		{"launch form create", "launchform/create.json"},
+		{"launch form update", "launchform/update.json"},
+		{"launch form delete", "launchform/delete.json"},
+		{"test case create", "tcase/create.json"},
+		{"test case update", "tcase/update.json"},
+		{"test case delete", "tcase/delete.json"},
+		{"test plan create", "test_plan/create.json"},
+		{"test plan update", "test_plan/update.json"},
+		{"test plan delete", "test_plan/delete.json"},
+		{"wiki create", "wiki/create.json"},
+		{"wiki update", "wiki/update.json"},
+		{"wiki delete", "wiki/delete.json"},
+		{"timesheet create", "timesheet/create.json"},
+		{"timesheet update", "timesheet/update.json"},
+		{"timesheet delete", "timesheet/delete.json"},
+		{"attachment add", "attachment/add.json"},
+		{"attachment delete", "attachment/delete.json"},
 	}
 
 	for _, tt := range tests {
@@ -132,6 +152,26 @@ var (
 	_ IterationCreateListener    = (*testListener)(nil)
 	_ IterationUpdateListener    = (*testListener)(nil)
 	_ IterationDeleteListener    = (*testListener)(nil)
+	_ ReleaseCreateListener      = (*testListener)(nil)
+	_ ReleaseUpdateListener      = (*testListener)(nil)
+	_ ReleaseDeleteListener      = (*testListener)(nil)
+	_ LaunchFormCreateListener   = (*testListener)(nil)
+	_ LaunchFormUpdateListener   = (*testListener)(nil)
+	_ LaunchFormDeleteListener   = (*testListener)(nil)
+	_ TestCaseCreateListener     = (*testListener)(nil)
+	_ TestCaseUpdateListener     = (*testListener)(nil)
+	_ TestCaseDeleteListener     = (*testListener)(nil)
+	_ TestPlanCreateListener     = (*testListener)(nil)
+	_ TestPlanUpdateListener     = (*testListener)(nil)
+	_ TestPlanDeleteListener     = (*testListener)(nil)
+	_ WikiCreateListener         = (*testListener)(nil)
+	_ WikiUpdateListener         = (*testListener)(nil)
+	_ WikiDeleteListener         = (*testListener)(nil)
+	_ TimesheetCreateListener    = (*testListener)(nil)
+	_ TimesheetUpdateListener    = (*testListener)(nil)
+	_ TimesheetDeleteListener    = (*testListener)(nil)
+	_ AttachmentAddListener      = (*testListener)(nil)
+	_ AttachmentDeleteListener   = (*testListener)(nil)
 )
 
 func (t testListener) OnStoryCreate(ctx context.Context, event *StoryCreateEvent) error {
@@ -259,3 +299,123 @@ func (t testListener) OnIterationDelete(ctx context.Context, event *IterationDel
 	assert.Equal(t.t, EventTypeIterationDelete, event.Event)
 	return nil
 }
+
+func (t testListener) OnReleaseCreate(ctx context.Context, event *ReleaseCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeReleaseCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnReleaseUpdate(ctx context.Context, event *ReleaseUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeReleaseUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnReleaseDelete(ctx context.Context, event *ReleaseDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeReleaseDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnLaunchFormCreate(ctx context.Context, event *LaunchFormCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeLaunchFormCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnLaunchFormUpdate(ctx context.Context, event *LaunchFormUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeLaunchFormUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnLaunchFormDelete(ctx context.Context, event *LaunchFormDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeLaunchFormDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestCaseCreate(ctx context.Context, event *TestCaseCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestCaseCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestCaseUpdate(ctx context.Context, event *TestCaseUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestCaseUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestCaseDelete(ctx context.Context, event *TestCaseDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestCaseDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestPlanCreate(ctx context.Context, event *TestPlanCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestPlanCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestPlanUpdate(ctx context.Context, event *TestPlanUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestPlanUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTestPlanDelete(ctx context.Context, event *TestPlanDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTestPlanDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnWikiCreate(ctx context.Context, event *WikiCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeWikiCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnWikiUpdate(ctx context.Context, event *WikiUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeWikiUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnWikiDelete(ctx context.Context, event *WikiDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeWikiDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnTimesheetCreate(ctx context.Context, event *TimesheetCreateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTimesheetCreate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTimesheetUpdate(ctx context.Context, event *TimesheetUpdateEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTimesheetUpdate, event.Event)
+	return nil
+}
+
+func (t testListener) OnTimesheetDelete(ctx context.Context, event *TimesheetDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeTimesheetDelete, event.Event)
+	return nil
+}
+
+func (t testListener) OnAttachmentAdd(ctx context.Context, event *AttachmentAddEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeAttachmentAdd, event.Event)
+	return nil
+}
+
+func (t testListener) OnAttachmentDelete(ctx context.Context, event *AttachmentDeleteEvent) error {
+	testDispatcherContext(ctx, t.t)
+	assert.Equal(t.t, EventTypeAttachmentDelete, event.Event)
+	return nil
+}
@@ -46,13 +46,64 @@ const (
 	EventTypeIterationCreate EventType = "iteration::create"
 	EventTypeIterationUpdate EventType = "iteration::update"
 	EventTypeIterationDelete EventType = "iteration::delete"
+
+	// ========================================
+	// 发布计划/发布评审
+	// ========================================
+
+	EventTypeReleaseCreate    EventType = "release::create"
+	EventTypeReleaseUpdate    EventType = "release::update"
+	EventTypeReleaseDelete    EventType = "release::delete"
+	EventTypeLaunchFormCreate EventType = "launchform::create"
+	EventTypeLaunchFormUpdate EventType = "launchform::update"
+	EventTypeLaunchFormDelete EventType = "launchform::delete"
+
+	// ========================================
+	// 测试用例/测试计划
+	// ========================================
+
+	EventTypeTestCaseCreate EventType = "tcase::create"
+	EventTypeTestCaseUpdate EventType = "tcase::update"
+	EventTypeTestCaseDelete EventType = "tcase::delete"
+	EventTypeTestPlanCreate EventType = "test_plan::create"
+	EventTypeTestPlanUpdate EventType = "test_plan::update"
+	EventTypeTestPlanDelete EventType = "test_plan::delete"
+
+	// ========================================
+	// Wiki
+	// ========================================
+
+	EventTypeWikiCreate EventType = "wiki::create"
+	EventTypeWikiUpdate EventType = "wiki::update"
+	EventTypeWikiDelete EventType = "wiki::delete"
+
+	// ========================================
+	// 工时
+	// ========================================
+
+	EventTypeTimesheetCreate EventType = "timesheet::create"
+	EventTypeTimesheetUpdate EventType = "timesheet::update"
+	EventTypeTimesheetDelete EventType = "timesheet::delete"
+
+	// ========================================
+	// 附件
+	// ========================================
+
+	EventTypeAttachmentAdd    EventType = "attachment::add"
+	EventTypeAttachmentDelete EventType = "attachment::delete"
 )
 
+// ErrUnsupportedEvent is reported when parsing or dispatching an event of an
+// unsupported type in strict mode.
+var ErrUnsupportedEvent = errors.New("tapd: webhook event type not supported")
+
 func (e EventType) String() string {
 	return string(e)
 }
 
-// ParseWebhookEvent parses the webhook event from the payload.
+// ParseWebhookEvent parses the webhook event from the payload. Events of
+// unsupported types are reported with ErrUnsupportedEvent, see
+// ParseWebhookEventWithMode to accept them.
 func ParseWebhookEvent(payload []byte) (EventType, any, error) {
 	var raw map[string]any
 	if err := json.Unmarshal(payload, &raw); err != nil {
@@ -109,8 +160,48 @@ func ParseWebhookEvent(payload []byte) (EventType, any, error) {
 		return decodeWebhookEvent[IterationUpdateEvent](EventTypeIterationUpdate, payload)
 	case EventTypeIterationDelete:
 		return decodeWebhookEvent[IterationDeleteEvent](EventTypeIterationDelete, payload)
+	case EventTypeReleaseCreate:
+		return decodeWebhookEvent[ReleaseCreateEvent](EventTypeReleaseCreate, payload)
+	case EventTypeReleaseUpdate:
+		return decodeWebhookEvent[ReleaseUpdateEvent](EventTypeReleaseUpdate, payload)
+	case EventTypeReleaseDelete:
+		return decodeWebhookEvent[ReleaseDeleteEvent](EventTypeReleaseDelete, payload)
+	case EventTypeLaunchFormCreate:
+		return decodeWebhookEvent[LaunchFormCreateEvent](EventTypeLaunchFormCreate, payload)
+	case EventTypeLaunchFormUpdate:
+		return decodeWebhookEvent[LaunchFormUpdateEvent](EventTypeLaunchFormUpdate, payload)
+	case EventTypeLaunchFormDelete:
+		return decodeWebhookEvent[LaunchFormDeleteEvent](EventTypeLaunchFormDelete, payload)
+	case EventTypeTestCaseCreate:
+		return decodeWebhookEvent[TestCaseCreateEvent](EventTypeTestCaseCreate, payload)
+	case EventTypeTestCaseUpdate:
+		return decodeWebhookEvent[TestCaseUpdateEvent](EventTypeTestCaseUpdate, payload)
+	case EventTypeTestCaseDelete:
+		return decodeWebhookEvent[TestCaseDeleteEvent](EventTypeTestCaseDelete, payload)
+	case EventTypeTestPlanCreate:
+		return decodeWebhookEvent[TestPlanCreateEvent](EventTypeTestPlanCreate, payload)
+	case EventTypeTestPlanUpdate:
+		return decodeWebhookEvent[TestPlanUpdateEvent](EventTypeTestPlanUpdate, payload)
+	case EventTypeTestPlanDelete:
+		return decodeWebhookEvent[TestPlanDeleteEvent](EventTypeTestPlanDelete, payload)
+	case EventTypeWikiCreate:
+		return decodeWebhookEvent[WikiCreateEvent](EventTypeWikiCreate, payload)
+	case EventTypeWikiUpdate:
+		return decodeWebhookEvent[WikiUpdateEvent](EventTypeWikiUpdate, payload)
+	case EventTypeWikiDelete:
+		return decodeWebhookEvent[WikiDeleteEvent](EventTypeWikiDelete, payload)
+	case EventTypeTimesheetCreate:
+		return decodeWebhookEvent[TimesheetCreateEvent](EventTypeTimesheetCreate, payload)
+	case EventTypeTimesheetUpdate:
+		return decodeWebhookEvent[TimesheetUpdateEvent](EventTypeTimesheetUpdate, payload)
+	case EventTypeTimesheetDelete:
+		return decodeWebhookEvent[TimesheetDeleteEvent](EventTypeTimesheetDelete, payload)
+	case EventTypeAttachmentAdd:
+		return decodeWebhookEvent[AttachmentAddEvent](EventTypeAttachmentAdd, payload)
+	case EventTypeAttachmentDelete:
+		return decodeWebhookEvent[AttachmentDeleteEvent](EventTypeAttachmentDelete, payload)
 	default: // todo: add more event types
-		return "", nil, fmt.Errorf("tapd: webhook event type [%s] not supported", event)
+		return "", nil, fmt.Errorf("%w [%s]", ErrUnsupportedEvent, event)
 	}
 }
 
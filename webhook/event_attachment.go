@@ -0,0 +1,43 @@
+package webhook
+
+// AttachmentAddEvent represents the attachment add event.
+type AttachmentAddEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	EntryID      string    `json:"entry_id,omitempty"`
+	Filename     string    `json:"filename,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	ContentType  string    `json:"content_type,omitempty"`
+	Owner        string    `json:"owner,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
+
+// AttachmentDeleteEvent represents the attachment delete event.
+type AttachmentDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	EntryID      string    `json:"entry_id,omitempty"`
+	Filename     string    `json:"filename,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
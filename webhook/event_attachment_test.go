@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentEvent_AttachmentAddEvent(t *testing.T) {
+	var event AttachmentAddEvent
+	loadAndParseWebhookData(t, "attachment/add.json", &event)
+
+	assert.Equal(t, EventTypeAttachmentAdd, event.Event)
+	assert.Equal(t, "web", event.EventFrom)
+	assert.Equal(t, "https://www.tapd.cn/1112223/prong/stories/view/1111112223001071295", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "张三", event.CurrentUser)
+	assert.Equal(t, "1111112223001027784", event.ID)
+	assert.Equal(t, "story", event.Type)
+	assert.Equal(t, "1111112223001071295", event.EntryID)
+	assert.Equal(t, "需求说明.pdf", event.Filename)
+	assert.Equal(t, "application/pdf", event.ContentType)
+	assert.Equal(t, "张三", event.Owner)
+	assert.Equal(t, "319226605", event.QueueID)
+	assert.Equal(t, "183914052", event.EventID)
+	assert.Equal(t, "2025-01-05 10:12:44", event.Created)
+}
+
+func TestAttachmentEvent_AttachmentDeleteEvent(t *testing.T) {
+	var event AttachmentDeleteEvent
+	loadAndParseWebhookData(t, "attachment/delete.json", &event)
+
+	assert.Equal(t, EventTypeAttachmentDelete, event.Event)
+	assert.Equal(t, "1111112223001027784", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "story", event.Type)
+	assert.Equal(t, "1111112223001071295", event.EntryID)
+	assert.Equal(t, "需求说明.pdf", event.Filename)
+	assert.Equal(t, "183914433", event.EventID)
+}
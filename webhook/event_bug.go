@@ -1,6 +1,10 @@
 package webhook
 
-import "github.com/go-tapd/tapd"
+import (
+	"encoding/json"
+
+	"github.com/go-tapd/tapd"
+)
 
 type BugCreateEvent struct {
 	Event            EventType        `json:"event,omitempty"`
@@ -54,6 +58,8 @@ type BugCreateEvent struct {
 	QueueID          string           `json:"queue_id,omitempty"`
 	EventID          string           `json:"event_id,omitempty"`
 	Created          string           `json:"created,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type BugUpdateEvent struct {
@@ -652,6 +658,9 @@ type BugUpdateEvent struct {
 	QueueID                string           `json:"queue_id,omitempty"`
 	EventID                string           `json:"event_id,omitempty"`
 	Created                string           `json:"created,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type BugDeleteEvent struct {
@@ -670,3 +679,37 @@ type BugDeleteEvent struct {
 	EventID        string    `json:"event_id,omitempty"`
 	Created        string    `json:"created,omitempty"`
 }
+
+func (e *BugCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias BugCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *BugUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias BugUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
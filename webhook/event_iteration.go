@@ -1,5 +1,11 @@
 package webhook
 
+import (
+	"encoding/json"
+
+	"github.com/go-tapd/tapd"
+)
+
 type IterationCreateEvent struct {
 	Event               EventType `json:"event,omitempty"`
 	EventFrom           string    `json:"event_from,omitempty"`
@@ -25,6 +31,8 @@ type IterationCreateEvent struct {
 	QueueID             string    `json:"queue_id,omitempty"`
 	EventID             string    `json:"event_id,omitempty"`
 	Created             string    `json:"created,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type IterationUpdateEvent struct {
@@ -233,6 +241,9 @@ type IterationUpdateEvent struct {
 	QueueID                 string    `json:"queue_id,omitempty"`
 	EventID                 string    `json:"event_id,omitempty"`
 	Created                 string    `json:"created,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type IterationDeleteEvent struct {
@@ -250,3 +261,37 @@ type IterationDeleteEvent struct {
 	EventID      string    `json:"event_id,omitempty"`
 	Created      string    `json:"created,omitempty"`
 }
+
+func (e *IterationCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias IterationCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *IterationUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias IterationUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
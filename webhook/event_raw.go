@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ParseMode decides how events of unsupported types are parsed.
+type ParseMode int
+
+const (
+	ParseStrict  ParseMode = iota // 不支持的事件类型返回错误
+	ParseLenient                  // 不支持的事件类型解析为 *RawEvent
+)
+
+// RawEvent represents an event whose type is not supported by this package,
+// parsed in lenient mode.
+type RawEvent struct {
+	Event       EventType      `json:"event,omitempty"`
+	WorkspaceID string         `json:"workspace_id,omitempty"`
+	ID          string         `json:"id,omitempty"`
+	EventID     string         `json:"event_id,omitempty"`
+	Data        map[string]any `json:"-"` // 完整的原始事件，数字解析为 json.Number
+}
+
+// String returns the value of the key in the raw event formatted as a string,
+// or an empty string if it is missing.
+func (e *RawEvent) String(key string) string {
+	switch value := e.Data[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// Decode decodes the raw event into v, e.g. a struct defined by the caller.
+func (e *RawEvent) Decode(v any) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// ParseWebhookEventWithMode is like ParseWebhookEvent, but in ParseLenient mode
+// events of unsupported types are returned as *RawEvent instead of an error.
+func ParseWebhookEventWithMode(payload []byte, mode ParseMode) (EventType, any, error) {
+	eventType, event, err := ParseWebhookEvent(payload)
+	if mode != ParseLenient || !errors.Is(err, ErrUnsupportedEvent) {
+		return eventType, event, err
+	}
+
+	raw, err := decodeRawEvent(payload)
+	if err != nil {
+		return "", nil, err
+	}
+	return raw.Event, raw, nil
+}
+
+func decodeRawEvent(payload []byte) (*RawEvent, error) {
+	var data map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	event := &RawEvent{Data: data}
+	event.Event = EventType(event.String("event"))
+	event.WorkspaceID = event.String("workspace_id")
+	event.ID = event.String("id")
+	event.EventID = event.String("event_id")
+	return event, nil
+}
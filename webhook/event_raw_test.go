@@ -0,0 +1,105 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rawEventPayload = []byte(`{
+	"event": "future_object::create",
+	"workspace_id": "11112222",
+	"id": "1111112222001000001",
+	"event_id": "1687744333",
+	"name": "future page",
+	"creator": "张三",
+	"version": 3
+}`)
+
+func TestParseWebhookEventWithMode(t *testing.T) {
+	_, _, err := ParseWebhookEventWithMode(rawEventPayload, ParseStrict)
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+	assert.EqualError(t, err, "tapd: webhook event type not supported [future_object::create]")
+
+	eventType, event, err := ParseWebhookEventWithMode(rawEventPayload, ParseLenient)
+	require.NoError(t, err)
+	assert.Equal(t, EventType("future_object::create"), eventType)
+
+	raw, ok := event.(*RawEvent)
+	require.True(t, ok)
+	assert.Equal(t, EventType("future_object::create"), raw.Event)
+	assert.Equal(t, "11112222", raw.WorkspaceID)
+	assert.Equal(t, "1111112222001000001", raw.ID)
+	assert.Equal(t, "1687744333", raw.EventID)
+	assert.Equal(t, "future page", raw.String("name"))
+	assert.Equal(t, "3", raw.String("version"))
+	assert.Equal(t, json.Number("3"), raw.Data["version"])
+	assert.Empty(t, raw.String("missing"))
+
+	var future struct {
+		Name    string `json:"name"`
+		Version int    `json:"version"`
+	}
+	require.NoError(t, raw.Decode(&future))
+	assert.Equal(t, "future page", future.Name)
+	assert.Equal(t, 3, future.Version)
+
+	// supported events are parsed as usual
+	_, event, err = ParseWebhookEventWithMode(loadWebhookData(t, "story/create.json"), ParseLenient)
+	require.NoError(t, err)
+	assert.IsType(t, &StoryCreateEvent{}, event)
+
+	_, _, err = ParseWebhookEventWithMode([]byte("{"), ParseLenient)
+	assert.Error(t, err)
+}
+
+func TestDispatcher_AnyEventListener(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		events []EventType
+		raws   []*RawEvent
+	)
+	dispatcher := NewDispatcher(WithParseMode(ParseLenient))
+	dispatcher.RegisterAnyEventListener(AnyEventListenerFunc(func(ctx context.Context, eventType EventType, event any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, eventType)
+		return nil
+	}))
+	On(dispatcher, func(ctx context.Context, event *RawEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		raws = append(raws, event)
+		return nil
+	})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")))
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), rawEventPayload))
+	assert.Equal(t, []EventType{EventTypeStoryCreate, "future_object::create"}, events)
+	require.Len(t, raws, 1)
+	assert.Equal(t, "1111112222001000001", raws[0].ID)
+
+	assert.True(t, CreatedBy("张三")(raws[0]))
+	assert.True(t, InWorkspace(11112222)(raws[0]))
+
+	assert.ErrorIs(t, dispatcher.Dispatch(t.Context(), struct{}{}), ErrUnsupportedEvent)
+	assert.ErrorIs(t, NewDispatcher().DispatchPayload(t.Context(), rawEventPayload), ErrUnsupportedEvent)
+}
+
+func TestHandler_ServeHTTP_Lenient(t *testing.T) {
+	var called bool
+	dispatcher := NewDispatcher(WithParseMode(ParseLenient))
+	On(dispatcher, func(ctx context.Context, event *RawEvent) error {
+		called = true
+		return nil
+	})
+
+	resp := serveWebhook(t, NewHandler(dispatcher), http.MethodPost, rawEventPayload)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.True(t, called)
+}
@@ -0,0 +1,182 @@
+package webhook
+
+// ReleaseCreateEvent represents the release plan create event.
+type ReleaseCreateEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	StartDate    string    `json:"startdate,omitempty"`
+	EndDate      string    `json:"enddate,omitempty"`
+	Creator      string    `json:"creator,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	Modified     string    `json:"modified,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
+
+// ReleaseUpdateEvent represents the release plan update event.
+type ReleaseUpdateEvent struct {
+	Event          EventType `json:"event,omitempty"`
+	EventFrom      string    `json:"event_from,omitempty"`
+	Referer        string    `json:"referer,omitempty"`
+	WorkspaceID    string    `json:"workspace_id,omitempty"`
+	CurrentUser    string    `json:"current_user,omitempty"`
+	ID             string    `json:"id,omitempty"`
+	ChangeFields   string    `json:"change_fields,omitempty"`
+	Secret         string    `json:"secret,omitempty"`
+	RioToken       string    `json:"rio_token,omitempty"`
+	DevProxyHost   string    `json:"devproxy_host,omitempty"`
+	QueueID        string    `json:"queue_id,omitempty"`
+	EventID        string    `json:"event_id,omitempty"`
+	Created        string    `json:"created,omitempty"`
+	OldName        string    `json:"old_name,omitempty"`
+	OldDescription string    `json:"old_description,omitempty"`
+	OldStartDate   string    `json:"old_startdate,omitempty"`
+	OldEndDate     string    `json:"old_enddate,omitempty"`
+	OldCreator     string    `json:"old_creator,omitempty"`
+	OldStatus      string    `json:"old_status,omitempty"`
+	OldModified    string    `json:"old_modified,omitempty"`
+	NewName        string    `json:"new_name,omitempty"`
+	NewDescription string    `json:"new_description,omitempty"`
+	NewStartDate   string    `json:"new_startdate,omitempty"`
+	NewEndDate     string    `json:"new_enddate,omitempty"`
+	NewCreator     string    `json:"new_creator,omitempty"`
+	NewStatus      string    `json:"new_status,omitempty"`
+	NewModified    string    `json:"new_modified,omitempty"`
+}
+
+// ReleaseDeleteEvent represents the release plan delete event.
+type ReleaseDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
+
+// LaunchFormCreateEvent represents the launch form create event.
+type LaunchFormCreateEvent struct {
+	Event          EventType `json:"event,omitempty"`
+	EventFrom      string    `json:"event_from,omitempty"`
+	Referer        string    `json:"referer,omitempty"`
+	WorkspaceID    string    `json:"workspace_id,omitempty"`
+	CurrentUser    string    `json:"current_user,omitempty"`
+	ID             string    `json:"id,omitempty"`
+	Title          string    `json:"title,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Creator        string    `json:"creator,omitempty"`
+	Status         string    `json:"status,omitempty"`
+	VersionType    string    `json:"version_type,omitempty"`
+	Baseline       string    `json:"baseline,omitempty"`
+	ReleaseModel   string    `json:"release_model,omitempty"`
+	RoadmapVersion string    `json:"roadmap_version,omitempty"`
+	ReleaseType    string    `json:"release_type,omitempty"`
+	ChangeType     string    `json:"change_type,omitempty"`
+	SignedBy       string    `json:"signed_by,omitempty"`
+	ArchivedBy     string    `json:"archived_by,omitempty"`
+	CC             string    `json:"cc,omitempty"`
+	ChangeNotifier string    `json:"change_notifier,omitempty"`
+	Participator   string    `json:"participator,omitempty"`
+	Remark         string    `json:"remark,omitempty"`
+	TemplateID     string    `json:"template_id,omitempty"`
+	IterationID    string    `json:"iteration_id,omitempty"`
+	ReleaseID      string    `json:"release_id,omitempty"`
+	Modified       string    `json:"modified,omitempty"`
+	Secret         string    `json:"secret,omitempty"`
+	RioToken       string    `json:"rio_token,omitempty"`
+	DevProxyHost   string    `json:"devproxy_host,omitempty"`
+	QueueID        string    `json:"queue_id,omitempty"`
+	EventID        string    `json:"event_id,omitempty"`
+	Created        string    `json:"created,omitempty"`
+}
+
+// LaunchFormUpdateEvent represents the launch form update event.
+type LaunchFormUpdateEvent struct {
+	Event             EventType `json:"event,omitempty"`
+	EventFrom         string    `json:"event_from,omitempty"`
+	Referer           string    `json:"referer,omitempty"`
+	WorkspaceID       string    `json:"workspace_id,omitempty"`
+	CurrentUser       string    `json:"current_user,omitempty"`
+	ID                string    `json:"id,omitempty"`
+	ChangeFields      string    `json:"change_fields,omitempty"`
+	Secret            string    `json:"secret,omitempty"`
+	RioToken          string    `json:"rio_token,omitempty"`
+	DevProxyHost      string    `json:"devproxy_host,omitempty"`
+	QueueID           string    `json:"queue_id,omitempty"`
+	EventID           string    `json:"event_id,omitempty"`
+	Created           string    `json:"created,omitempty"`
+	OldTitle          string    `json:"old_title,omitempty"`
+	OldName           string    `json:"old_name,omitempty"`
+	OldCreator        string    `json:"old_creator,omitempty"`
+	OldStatus         string    `json:"old_status,omitempty"`
+	OldVersionType    string    `json:"old_version_type,omitempty"`
+	OldBaseline       string    `json:"old_baseline,omitempty"`
+	OldReleaseModel   string    `json:"old_release_model,omitempty"`
+	OldRoadmapVersion string    `json:"old_roadmap_version,omitempty"`
+	OldReleaseType    string    `json:"old_release_type,omitempty"`
+	OldChangeType     string    `json:"old_change_type,omitempty"`
+	OldSignedBy       string    `json:"old_signed_by,omitempty"`
+	OldArchivedBy     string    `json:"old_archived_by,omitempty"`
+	OldCC             string    `json:"old_cc,omitempty"`
+	OldChangeNotifier string    `json:"old_change_notifier,omitempty"`
+	OldParticipator   string    `json:"old_participator,omitempty"`
+	OldRemark         string    `json:"old_remark,omitempty"`
+	OldTemplateID     string    `json:"old_template_id,omitempty"`
+	OldIterationID    string    `json:"old_iteration_id,omitempty"`
+	OldReleaseID      string    `json:"old_release_id,omitempty"`
+	OldModified       string    `json:"old_modified,omitempty"`
+	NewTitle          string    `json:"new_title,omitempty"`
+	NewName           string    `json:"new_name,omitempty"`
+	NewCreator        string    `json:"new_creator,omitempty"`
+	NewStatus         string    `json:"new_status,omitempty"`
+	NewVersionType    string    `json:"new_version_type,omitempty"`
+	NewBaseline       string    `json:"new_baseline,omitempty"`
+	NewReleaseModel   string    `json:"new_release_model,omitempty"`
+	NewRoadmapVersion string    `json:"new_roadmap_version,omitempty"`
+	NewReleaseType    string    `json:"new_release_type,omitempty"`
+	NewChangeType     string    `json:"new_change_type,omitempty"`
+	NewSignedBy       string    `json:"new_signed_by,omitempty"`
+	NewArchivedBy     string    `json:"new_archived_by,omitempty"`
+	NewCC             string    `json:"new_cc,omitempty"`
+	NewChangeNotifier string    `json:"new_change_notifier,omitempty"`
+	NewParticipator   string    `json:"new_participator,omitempty"`
+	NewRemark         string    `json:"new_remark,omitempty"`
+	NewTemplateID     string    `json:"new_template_id,omitempty"`
+	NewIterationID    string    `json:"new_iteration_id,omitempty"`
+	NewReleaseID      string    `json:"new_release_id,omitempty"`
+	NewModified       string    `json:"new_modified,omitempty"`
+}
+
+// LaunchFormDeleteEvent represents the launch form delete event.
+type LaunchFormDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
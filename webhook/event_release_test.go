@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseEvent_ReleaseCreateEvent(t *testing.T) {
+	var event ReleaseCreateEvent
+	loadAndParseWebhookData(t, "release/create.json", &event)
+
+	assert.Equal(t, EventTypeReleaseCreate, event.Event)
+	assert.Equal(t, "web", event.EventFrom)
+	assert.Equal(t, "https://www.tapd.cn/1112223/releases/index", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "张三", event.CurrentUser)
+	assert.Equal(t, "1111112223001000215", event.ID)
+	assert.Equal(t, "v1.2.0 发布计划", event.Name)
+	assert.Equal(t, "<p>1.2.0 版本发布</p>", event.Description)
+	assert.Equal(t, "2025-01-06", event.StartDate)
+	assert.Equal(t, "2025-01-10", event.EndDate)
+	assert.Equal(t, "张三", event.Creator)
+	assert.Equal(t, "open", event.Status)
+	assert.Equal(t, "", event.Secret)
+	assert.Equal(t, "http://websocket-proxy", event.DevProxyHost)
+	assert.Equal(t, "319201336", event.QueueID)
+	assert.Equal(t, "183902011", event.EventID)
+	assert.Equal(t, "2025-01-02 10:21:33", event.Created)
+}
+
+func TestReleaseEvent_ReleaseUpdateEvent(t *testing.T) {
+	var event ReleaseUpdateEvent
+	loadAndParseWebhookData(t, "release/update.json", &event)
+
+	assert.Equal(t, EventTypeReleaseUpdate, event.Event)
+	assert.Equal(t, "1111112223001000215", event.ID)
+	assert.Equal(t, "enddate,status,modified", event.ChangeFields)
+	assert.Equal(t, "2025-01-10", event.OldEndDate)
+	assert.Equal(t, "2025-01-13", event.NewEndDate)
+	assert.Equal(t, "open", event.OldStatus)
+	assert.Equal(t, "done", event.NewStatus)
+	assert.Empty(t, event.NewName)
+	assert.Equal(t, "183905127", event.EventID)
+}
+
+func TestReleaseEvent_ReleaseDeleteEvent(t *testing.T) {
+	var event ReleaseDeleteEvent
+	loadAndParseWebhookData(t, "release/delete.json", &event)
+
+	assert.Equal(t, EventTypeReleaseDelete, event.Event)
+	assert.Equal(t, "1111112223001000215", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "319210073", event.QueueID)
+	assert.Equal(t, "183906532", event.EventID)
+	assert.Equal(t, "2025-01-03 17:40:08", event.Created)
+}
+
+func TestReleaseEvent_LaunchFormCreateEvent(t *testing.T) {
+	var event LaunchFormCreateEvent
+	loadAndParseWebhookData(t, "launchform/create.json", &event)
+
+	assert.Equal(t, EventTypeLaunchFormCreate, event.Event)
+	assert.Equal(t, "https://www.tapd.cn/1112223/releases/launchform_list", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "1111112223001000087", event.ID)
+	assert.Equal(t, "v1.2.0 发布评审", event.Title)
+	assert.Equal(t, "v1.2.0", event.Name)
+	assert.Equal(t, "wait_audit", event.Status)
+	assert.Equal(t, "正式版本", event.VersionType)
+	assert.Equal(t, "常规发布", event.ReleaseType)
+	assert.Equal(t, "李四", event.SignedBy)
+	assert.Equal(t, "王五;", event.CC)
+	assert.Equal(t, "张三;李四;", event.Participator)
+	assert.Equal(t, "1111112223001000012", event.TemplateID)
+	assert.Equal(t, "1111112223001002244", event.IterationID)
+	assert.Equal(t, "1111112223001000215", event.ReleaseID)
+	assert.Equal(t, "183918842", event.EventID)
+}
+
+func TestReleaseEvent_LaunchFormUpdateEvent(t *testing.T) {
+	var event LaunchFormUpdateEvent
+	loadAndParseWebhookData(t, "launchform/update.json", &event)
+
+	assert.Equal(t, EventTypeLaunchFormUpdate, event.Event)
+	assert.Equal(t, "status,archived_by,modified", event.ChangeFields)
+	assert.Equal(t, "wait_audit", event.OldStatus)
+	assert.Equal(t, "finished", event.NewStatus)
+	assert.Equal(t, "", event.OldArchivedBy)
+	assert.Equal(t, "李四", event.NewArchivedBy)
+
+	from, to, ok := event.StatusChanged()
+	assert.True(t, ok)
+	assert.Equal(t, "wait_audit", from)
+	assert.Equal(t, "finished", to)
+}
+
+func TestReleaseEvent_LaunchFormDeleteEvent(t *testing.T) {
+	var event LaunchFormDeleteEvent
+	loadAndParseWebhookData(t, "launchform/delete.json", &event)
+
+	assert.Equal(t, EventTypeLaunchFormDelete, event.Event)
+	assert.Equal(t, "1111112223001000087", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "183922061", event.EventID)
+}
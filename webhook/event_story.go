@@ -1,6 +1,10 @@
 package webhook
 
-import "github.com/go-tapd/tapd"
+import (
+	"encoding/json"
+
+	"github.com/go-tapd/tapd"
+)
 
 // StoryCreateEvent represents the story create event.
 type StoryCreateEvent struct {
@@ -18,6 +22,7 @@ type StoryCreateEvent struct {
 	Due               string           `json:"due,omitempty"`
 	Creator           string           `json:"creator,omitempty"`
 	Priority          string           `json:"priority,omitempty"`
+	IterationID       string           `json:"iteration_id,omitempty"`
 	WorkitemTypeID    string           `json:"workitem_type_id,omitempty"`
 	Status            tapd.StoryStatus `json:"status,omitempty"`
 	TemplatedID       string           `json:"templated_id,omitempty"`
@@ -92,6 +97,8 @@ type StoryCreateEvent struct {
 	QueueID           string           `json:"queue_id,omitempty"`
 	EventID           string           `json:"event_id,omitempty"`
 	Created           string           `json:"created,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 // StoryUpdateEvent represents the story update event.
@@ -665,6 +672,9 @@ type StoryUpdateEvent struct {
 	NewCustomPlanField8    string           `json:"new_custom_plan_field_8,omitempty"`
 	NewCustomPlanField9    string           `json:"new_custom_plan_field_9,omitempty"`
 	NewCustomPlanField10   string           `json:"new_custom_plan_field_10,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type StoryDeleteEvent struct {
@@ -683,3 +693,37 @@ type StoryDeleteEvent struct {
 	EventID        string    `json:"event_id,omitempty"`
 	Created        string    `json:"created,omitempty"`
 }
+
+func (e *StoryCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias StoryCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *StoryUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias StoryUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
@@ -36,6 +36,7 @@ func TestStoryEvent_StoryCreateEvent(t *testing.T) {
 	assert.Equal(t, "asdfasdfsadfasdf", event.Secret)
 	assert.Equal(t, "", event.RioToken)
 	assert.Equal(t, "", event.CustomFieldOne)
+	assert.Empty(t, event.CustomFields)
 	assert.Equal(t, "http://websocket-proxy", event.DevProxyHost)
 	assert.Equal(t, "2822451111", event.QueueID)
 	assert.Equal(t, "1687744222", event.EventID)
@@ -79,6 +80,9 @@ func TestStoryEvent_StoryUpdateEvent(t *testing.T) {
 	assert.Equal(t, tapd.StoryStatusAudited, event.OldStatus)
 	assert.Equal(t, "1", event.OldAppID)
 	assert.Equal(t, "old owner", event.OldOwner)
+	assert.Equal(t, tapd.CustomFieldValue("old custom field 98"), event.OldCustomFields.Get("custom_field_98"))
+	assert.Equal(t, tapd.CustomFieldValue(""), event.OldCustomFields.Get("custom_field_one"))
+	assert.Empty(t, event.NewCustomFields)
 }
 
 func TestStoryEvent_StoryDeleteEvent(t *testing.T) {
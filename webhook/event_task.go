@@ -1,6 +1,10 @@
 package webhook
 
-import "github.com/go-tapd/tapd"
+import (
+	"encoding/json"
+
+	"github.com/go-tapd/tapd"
+)
 
 type TaskCreateEvent struct {
 	Event               EventType       `json:"event,omitempty"`
@@ -40,6 +44,8 @@ type TaskCreateEvent struct {
 	DevProxyHost        string          `json:"devproxy_host,omitempty"`
 	QueueID             string          `json:"queue_id,omitempty"`
 	EventID             string          `json:"event_id,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type TaskUpdateEvent struct {
@@ -262,6 +268,9 @@ type TaskUpdateEvent struct {
 	QueueID                string          `json:"queue_id,omitempty"`
 	EventID                string          `json:"event_id,omitempty"`
 	Created                string          `json:"created,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
 }
 
 type TaskDeleteEvent struct {
@@ -280,3 +289,37 @@ type TaskDeleteEvent struct {
 	EventID        string    `json:"event_id,omitempty"`
 	Created        string    `json:"created,omitempty"`
 }
+
+func (e *TaskCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias TaskCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *TaskUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias TaskUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/go-tapd/tapd"
+)
+
+// TestCaseCreateEvent represents the test case create event.
+type TestCaseCreateEvent struct {
+	Event        EventType           `json:"event,omitempty"`
+	EventFrom    string              `json:"event_from,omitempty"`
+	Referer      string              `json:"referer,omitempty"`
+	WorkspaceID  string              `json:"workspace_id,omitempty"`
+	CurrentUser  string              `json:"current_user,omitempty"`
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name,omitempty"`
+	CategoryID   string              `json:"category_id,omitempty"`
+	Status       tapd.TestCaseStatus `json:"status,omitempty"`
+	Precondition string              `json:"precondition,omitempty"`
+	Steps        string              `json:"steps,omitempty"`
+	Expectation  string              `json:"expectation,omitempty"`
+	Type         string              `json:"type,omitempty"`
+	Priority     string              `json:"priority,omitempty"`
+	IsAutomated  string              `json:"is_automated,omitempty"`
+	Version      string              `json:"version,omitempty"`
+	Creator      string              `json:"creator,omitempty"`
+	Modifier     string              `json:"modifier,omitempty"`
+	Modified     string              `json:"modified,omitempty"`
+	Secret       string              `json:"secret,omitempty"`
+	RioToken     string              `json:"rio_token,omitempty"`
+	DevProxyHost string              `json:"devproxy_host,omitempty"`
+	QueueID      string              `json:"queue_id,omitempty"`
+	EventID      string              `json:"event_id,omitempty"`
+	Created      string              `json:"created,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
+}
+
+// TestCaseUpdateEvent represents the test case update event.
+type TestCaseUpdateEvent struct {
+	Event           EventType           `json:"event,omitempty"`
+	EventFrom       string              `json:"event_from,omitempty"`
+	Referer         string              `json:"referer,omitempty"`
+	WorkspaceID     string              `json:"workspace_id,omitempty"`
+	CurrentUser     string              `json:"current_user,omitempty"`
+	ID              string              `json:"id,omitempty"`
+	ChangeFields    string              `json:"change_fields,omitempty"`
+	Secret          string              `json:"secret,omitempty"`
+	RioToken        string              `json:"rio_token,omitempty"`
+	DevProxyHost    string              `json:"devproxy_host,omitempty"`
+	QueueID         string              `json:"queue_id,omitempty"`
+	EventID         string              `json:"event_id,omitempty"`
+	Created         string              `json:"created,omitempty"`
+	OldName         string              `json:"old_name,omitempty"`
+	OldCategoryID   string              `json:"old_category_id,omitempty"`
+	OldStatus       tapd.TestCaseStatus `json:"old_status,omitempty"`
+	OldPrecondition string              `json:"old_precondition,omitempty"`
+	OldSteps        string              `json:"old_steps,omitempty"`
+	OldExpectation  string              `json:"old_expectation,omitempty"`
+	OldType         string              `json:"old_type,omitempty"`
+	OldPriority     string              `json:"old_priority,omitempty"`
+	OldIsAutomated  string              `json:"old_is_automated,omitempty"`
+	OldVersion      string              `json:"old_version,omitempty"`
+	OldCreator      string              `json:"old_creator,omitempty"`
+	OldModifier     string              `json:"old_modifier,omitempty"`
+	OldModified     string              `json:"old_modified,omitempty"`
+	NewName         string              `json:"new_name,omitempty"`
+	NewCategoryID   string              `json:"new_category_id,omitempty"`
+	NewStatus       tapd.TestCaseStatus `json:"new_status,omitempty"`
+	NewPrecondition string              `json:"new_precondition,omitempty"`
+	NewSteps        string              `json:"new_steps,omitempty"`
+	NewExpectation  string              `json:"new_expectation,omitempty"`
+	NewType         string              `json:"new_type,omitempty"`
+	NewPriority     string              `json:"new_priority,omitempty"`
+	NewIsAutomated  string              `json:"new_is_automated,omitempty"`
+	NewVersion      string              `json:"new_version,omitempty"`
+	NewCreator      string              `json:"new_creator,omitempty"`
+	NewModifier     string              `json:"new_modifier,omitempty"`
+	NewModified     string              `json:"new_modified,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
+}
+
+// TestCaseDeleteEvent represents the test case delete event.
+type TestCaseDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
+
+// TestPlanCreateEvent represents the test plan create event.
+type TestPlanCreateEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	Name         string    `json:"name,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Version      string    `json:"version,omitempty"`
+	Owner        string    `json:"owner,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	Type         string    `json:"type,omitempty"`
+	StartDate    string    `json:"start_date,omitempty"`
+	EndDate      string    `json:"end_date,omitempty"`
+	IterationID  string    `json:"iteration_id,omitempty"`
+	Creator      string    `json:"creator,omitempty"`
+	Modifier     string    `json:"modifier,omitempty"`
+	Modified     string    `json:"modified,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+
+	CustomFields tapd.CustomFields `json:"-"` // 自定义字段，按字段标识（如 custom_field_17）索引
+}
+
+// TestPlanUpdateEvent represents the test plan update event.
+type TestPlanUpdateEvent struct {
+	Event          EventType `json:"event,omitempty"`
+	EventFrom      string    `json:"event_from,omitempty"`
+	Referer        string    `json:"referer,omitempty"`
+	WorkspaceID    string    `json:"workspace_id,omitempty"`
+	CurrentUser    string    `json:"current_user,omitempty"`
+	ID             string    `json:"id,omitempty"`
+	ChangeFields   string    `json:"change_fields,omitempty"`
+	Secret         string    `json:"secret,omitempty"`
+	RioToken       string    `json:"rio_token,omitempty"`
+	DevProxyHost   string    `json:"devproxy_host,omitempty"`
+	QueueID        string    `json:"queue_id,omitempty"`
+	EventID        string    `json:"event_id,omitempty"`
+	Created        string    `json:"created,omitempty"`
+	OldName        string    `json:"old_name,omitempty"`
+	OldDescription string    `json:"old_description,omitempty"`
+	OldVersion     string    `json:"old_version,omitempty"`
+	OldOwner       string    `json:"old_owner,omitempty"`
+	OldStatus      string    `json:"old_status,omitempty"`
+	OldType        string    `json:"old_type,omitempty"`
+	OldStartDate   string    `json:"old_start_date,omitempty"`
+	OldEndDate     string    `json:"old_end_date,omitempty"`
+	OldIterationID string    `json:"old_iteration_id,omitempty"`
+	OldCreator     string    `json:"old_creator,omitempty"`
+	OldModifier    string    `json:"old_modifier,omitempty"`
+	OldModified    string    `json:"old_modified,omitempty"`
+	NewName        string    `json:"new_name,omitempty"`
+	NewDescription string    `json:"new_description,omitempty"`
+	NewVersion     string    `json:"new_version,omitempty"`
+	NewOwner       string    `json:"new_owner,omitempty"`
+	NewStatus      string    `json:"new_status,omitempty"`
+	NewType        string    `json:"new_type,omitempty"`
+	NewStartDate   string    `json:"new_start_date,omitempty"`
+	NewEndDate     string    `json:"new_end_date,omitempty"`
+	NewIterationID string    `json:"new_iteration_id,omitempty"`
+	NewCreator     string    `json:"new_creator,omitempty"`
+	NewModifier    string    `json:"new_modifier,omitempty"`
+	NewModified    string    `json:"new_modified,omitempty"`
+
+	OldCustomFields tapd.CustomFields `json:"-"` // 变更前的自定义字段，按字段标识（如 custom_field_17）索引
+	NewCustomFields tapd.CustomFields `json:"-"` // 变更后的自定义字段，按字段标识（如 custom_field_17）索引
+}
+
+// TestPlanDeleteEvent represents the test plan delete event.
+type TestPlanDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
+
+func (e *TestCaseCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias TestCaseCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *TestCaseUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias TestCaseUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
+
+func (e *TestPlanCreateEvent) UnmarshalJSON(data []byte) error {
+	type alias TestPlanCreateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	fields, err := tapd.ParseCustomFields(data, "")
+	if err != nil {
+		return err
+	}
+	e.CustomFields = fields
+
+	return nil
+}
+
+func (e *TestPlanUpdateEvent) UnmarshalJSON(data []byte) error {
+	type alias TestPlanUpdateEvent
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+
+	oldFields, err := tapd.ParseCustomFields(data, "old_")
+	if err != nil {
+		return err
+	}
+	newFields, err := tapd.ParseCustomFields(data, "new_")
+	if err != nil {
+		return err
+	}
+	e.OldCustomFields, e.NewCustomFields = oldFields, newFields
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/go-tapd/tapd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestCaseEvent_TestCaseCreateEvent(t *testing.T) {
+	var event TestCaseCreateEvent
+	loadAndParseWebhookData(t, "tcase/create.json", &event)
+
+	assert.Equal(t, EventTypeTestCaseCreate, event.Event)
+	assert.Equal(t, "web", event.EventFrom)
+	assert.Equal(t, "https://www.tapd.cn/1112223/sparrow/tcase/tcase_list", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "张三", event.CurrentUser)
+	assert.Equal(t, "1111112223001018830", event.ID)
+	assert.Equal(t, "登录页输入错误密码提示", event.Name)
+	assert.Equal(t, "1111112223001000342", event.CategoryID)
+	assert.Equal(t, tapd.TestCaseStatusNormal, event.Status)
+	assert.Equal(t, "<p>已注册账号</p>", event.Precondition)
+	assert.Equal(t, "<p>提示密码错误</p>", event.Expectation)
+	assert.Equal(t, "功能测试", event.Type)
+	assert.Equal(t, "高", event.Priority)
+	assert.Equal(t, "0", event.IsAutomated)
+	assert.Equal(t, "张三", event.Creator)
+	assert.Equal(t, tapd.CustomFieldValue("登录模块"), event.CustomFields.Get("custom_field_1"))
+	assert.Equal(t, "319258840", event.QueueID)
+	assert.Equal(t, "183930716", event.EventID)
+	assert.Equal(t, "2025-01-08 14:22:05", event.Created)
+}
+
+func TestTestCaseEvent_TestCaseUpdateEvent(t *testing.T) {
+	var event TestCaseUpdateEvent
+	loadAndParseWebhookData(t, "tcase/update.json", &event)
+
+	assert.Equal(t, EventTypeTestCaseUpdate, event.Event)
+	assert.Equal(t, "1111112223001018830", event.ID)
+	assert.Equal(t, "status,priority,custom_field_1,modified", event.ChangeFields)
+	assert.Equal(t, tapd.TestCaseStatusNormal, event.OldStatus)
+	assert.Equal(t, tapd.TestCaseStatusUpdating, event.NewStatus)
+	assert.Equal(t, tapd.CustomFieldValue("登录模块"), event.OldCustomFields.Get("custom_field_1"))
+	assert.Equal(t, tapd.CustomFieldValue("账号模块"), event.NewCustomFields.Get("custom_field_1"))
+
+	assert.Equal(t, []FieldChange{
+		{Field: "status", Old: "normal", New: "updating"},
+		{Field: "priority", Old: "高", New: "中"},
+		{Field: "custom_field_1", Old: "登录模块", New: "账号模块"},
+		{Field: "modified", Old: "2025-01-08 14:22:05", New: "2025-01-09 10:03:58"},
+	}, event.Changes())
+
+	from, to, ok := event.StatusChanged()
+	assert.True(t, ok)
+	assert.Equal(t, tapd.TestCaseStatusNormal, from)
+	assert.Equal(t, tapd.TestCaseStatusUpdating, to)
+}
+
+func TestTestCaseEvent_TestCaseDeleteEvent(t *testing.T) {
+	var event TestCaseDeleteEvent
+	loadAndParseWebhookData(t, "tcase/delete.json", &event)
+
+	assert.Equal(t, EventTypeTestCaseDelete, event.Event)
+	assert.Equal(t, "1111112223001018830", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "183935502", event.EventID)
+}
+
+func TestTestCaseEvent_TestPlanCreateEvent(t *testing.T) {
+	var event TestPlanCreateEvent
+	loadAndParseWebhookData(t, "test_plan/create.json", &event)
+
+	assert.Equal(t, EventTypeTestPlanCreate, event.Event)
+	assert.Equal(t, "https://www.tapd.cn/1112223/sparrow/test_plan/plan_list", event.Referer)
+	assert.Equal(t, "1111112223001000156", event.ID)
+	assert.Equal(t, "v1.2.0 回归测试", event.Name)
+	assert.Equal(t, "v1.2.0", event.Version)
+	assert.Equal(t, "李四;", event.Owner)
+	assert.Equal(t, "open", event.Status)
+	assert.Equal(t, "2025-01-08", event.StartDate)
+	assert.Equal(t, "2025-01-10", event.EndDate)
+	assert.Equal(t, "1111112223001002244", event.IterationID)
+	assert.Equal(t, tapd.CustomFieldValue("全量"), event.CustomFields.Get("custom_field_2"))
+	assert.Equal(t, "183924870", event.EventID)
+}
+
+func TestTestCaseEvent_TestPlanUpdateEvent(t *testing.T) {
+	var event TestPlanUpdateEvent
+	loadAndParseWebhookData(t, "test_plan/update.json", &event)
+
+	assert.Equal(t, EventTypeTestPlanUpdate, event.Event)
+	assert.Equal(t, "status,modifier,custom_field_1,modified", event.ChangeFields)
+	assert.Equal(t, "open", event.OldStatus)
+	assert.Equal(t, "done", event.NewStatus)
+	assert.Equal(t, tapd.CustomFieldValue("通过"), event.NewCustomFields.Get("custom_field_1"))
+
+	from, to, ok := event.StatusChanged()
+	assert.True(t, ok)
+	assert.Equal(t, "open", from)
+	assert.Equal(t, "done", to)
+}
+
+func TestTestCaseEvent_TestPlanDeleteEvent(t *testing.T) {
+	var event TestPlanDeleteEvent
+	loadAndParseWebhookData(t, "test_plan/delete.json", &event)
+
+	assert.Equal(t, EventTypeTestPlanDelete, event.Event)
+	assert.Equal(t, "1111112223001000156", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "183948690", event.EventID)
+}
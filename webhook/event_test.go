@@ -37,6 +37,31 @@ func TestWebhookEvent_EventType(t *testing.T) {
 		{"iteration::create", EventTypeIterationCreate},
 		{"iteration::update", EventTypeIterationUpdate},
 		{"iteration::delete", EventTypeIterationDelete},
+		// 发布计划/发布评审
+		{"release::create", EventTypeReleaseCreate},
+		{"release::update", EventTypeReleaseUpdate},
+		{"release::delete", EventTypeReleaseDelete},
+		{"launchform::create", EventTypeLaunchFormCreate},
+		{"launchform::update", EventTypeLaunchFormUpdate},
+		{"launchform::delete", EventTypeLaunchFormDelete},
+		// 测试用例/测试计划
+		{"tcase::create", EventTypeTestCaseCreate},
+		{"tcase::update", EventTypeTestCaseUpdate},
+		{"tcase::delete", EventTypeTestCaseDelete},
+		{"test_plan::create", EventTypeTestPlanCreate},
+		{"test_plan::update", EventTypeTestPlanUpdate},
+		{"test_plan::delete", EventTypeTestPlanDelete},
+		// Wiki
+		{"wiki::create", EventTypeWikiCreate},
+		{"wiki::update", EventTypeWikiUpdate},
+		{"wiki::delete", EventTypeWikiDelete},
+		// 工时
+		{"timesheet::create", EventTypeTimesheetCreate},
+		{"timesheet::update", EventTypeTimesheetUpdate},
+		{"timesheet::delete", EventTypeTimesheetDelete},
+		// 附件
+		{"attachment::add", EventTypeAttachmentAdd},
+		{"attachment::delete", EventTypeAttachmentDelete},
 	}
 
 	for _, tt := range tests {
@@ -77,6 +102,31 @@ func TestWebhookEvent_ParseWebhookEvent(t *testing.T) {
 		{"iteration/create.json", EventTypeIterationCreate, &IterationCreateEvent{}},
 		{"iteration/update.json", EventTypeIterationUpdate, &IterationUpdateEvent{}},
 		{"iteration/delete.json", EventTypeIterationDelete, &IterationDeleteEvent{}},
+		// 发布计划/发布评审
+		{"release/create.json", EventTypeReleaseCreate, &ReleaseCreateEvent{}},
+		{"release/update.json", EventTypeReleaseUpdate, &ReleaseUpdateEvent{}},
+		{"release/delete.json", EventTypeReleaseDelete, &ReleaseDeleteEvent{}},
+		{"launchform/create.json", EventTypeLaunchFormCreate, &LaunchFormCreateEvent{}},
+		{"launchform/update.json", EventTypeLaunchFormUpdate, &LaunchFormUpdateEvent{}},
+		{"launchform/delete.json", EventTypeLaunchFormDelete, &LaunchFormDeleteEvent{}},
+		// 测试用例/测试计划
+		{"tcase/create.json", EventTypeTestCaseCreate, &TestCaseCreateEvent{}},
+		{"tcase/update.json", EventTypeTestCaseUpdate, &TestCaseUpdateEvent{}},
+		{"tcase/delete.json", EventTypeTestCaseDelete, &TestCaseDeleteEvent{}},
+		{"test_plan/create.json", EventTypeTestPlanCreate, &TestPlanCreateEvent{}},
+		{"test_plan/update.json", EventTypeTestPlanUpdate, &TestPlanUpdateEvent{}},
+		{"test_plan/delete.json", EventTypeTestPlanDelete, &TestPlanDeleteEvent{}},
+		// Wiki
+		{"wiki/create.json", EventTypeWikiCreate, &WikiCreateEvent{}},
+		{"wiki/update.json", EventTypeWikiUpdate, &WikiUpdateEvent{}},
+		{"wiki/delete.json", EventTypeWikiDelete, &WikiDeleteEvent{}},
+		// 工时
+		{"timesheet/create.json", EventTypeTimesheetCreate, &TimesheetCreateEvent{}},
+		{"timesheet/update.json", EventTypeTimesheetUpdate, &TimesheetUpdateEvent{}},
+		{"timesheet/delete.json", EventTypeTimesheetDelete, &TimesheetDeleteEvent{}},
+		// 附件
+		{"attachment/add.json", EventTypeAttachmentAdd, &AttachmentAddEvent{}},
+		{"attachment/delete.json", EventTypeAttachmentDelete, &AttachmentDeleteEvent{}},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,79 @@
+package webhook
+
+import "github.com/go-tapd/tapd"
+
+// TimesheetCreateEvent represents the timesheet create event.
+type TimesheetCreateEvent struct {
+	Event        EventType       `json:"event,omitempty"`
+	EventFrom    string          `json:"event_from,omitempty"`
+	Referer      string          `json:"referer,omitempty"`
+	WorkspaceID  string          `json:"workspace_id,omitempty"`
+	CurrentUser  string          `json:"current_user,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	EntityType   tapd.EntityType `json:"entity_type,omitempty"`
+	EntityID     string          `json:"entity_id,omitempty"`
+	Timespent    string          `json:"timespent,omitempty"`
+	Timeremain   string          `json:"timeremain,omitempty"`
+	Spentdate    string          `json:"spentdate,omitempty"`
+	Owner        string          `json:"owner,omitempty"`
+	Memo         string          `json:"memo,omitempty"`
+	Modified     string          `json:"modified,omitempty"`
+	Secret       string          `json:"secret,omitempty"`
+	RioToken     string          `json:"rio_token,omitempty"`
+	DevProxyHost string          `json:"devproxy_host,omitempty"`
+	QueueID      string          `json:"queue_id,omitempty"`
+	EventID      string          `json:"event_id,omitempty"`
+	Created      string          `json:"created,omitempty"`
+}
+
+// TimesheetUpdateEvent represents the timesheet update event.
+type TimesheetUpdateEvent struct {
+	Event         EventType       `json:"event,omitempty"`
+	EventFrom     string          `json:"event_from,omitempty"`
+	Referer       string          `json:"referer,omitempty"`
+	WorkspaceID   string          `json:"workspace_id,omitempty"`
+	CurrentUser   string          `json:"current_user,omitempty"`
+	ID            string          `json:"id,omitempty"`
+	ChangeFields  string          `json:"change_fields,omitempty"`
+	Secret        string          `json:"secret,omitempty"`
+	RioToken      string          `json:"rio_token,omitempty"`
+	DevProxyHost  string          `json:"devproxy_host,omitempty"`
+	QueueID       string          `json:"queue_id,omitempty"`
+	EventID       string          `json:"event_id,omitempty"`
+	Created       string          `json:"created,omitempty"`
+	OldEntityType tapd.EntityType `json:"old_entity_type,omitempty"`
+	OldEntityID   string          `json:"old_entity_id,omitempty"`
+	OldTimespent  string          `json:"old_timespent,omitempty"`
+	OldTimeremain string          `json:"old_timeremain,omitempty"`
+	OldSpentdate  string          `json:"old_spentdate,omitempty"`
+	OldOwner      string          `json:"old_owner,omitempty"`
+	OldMemo       string          `json:"old_memo,omitempty"`
+	OldModified   string          `json:"old_modified,omitempty"`
+	NewEntityType tapd.EntityType `json:"new_entity_type,omitempty"`
+	NewEntityID   string          `json:"new_entity_id,omitempty"`
+	NewTimespent  string          `json:"new_timespent,omitempty"`
+	NewTimeremain string          `json:"new_timeremain,omitempty"`
+	NewSpentdate  string          `json:"new_spentdate,omitempty"`
+	NewOwner      string          `json:"new_owner,omitempty"`
+	NewMemo       string          `json:"new_memo,omitempty"`
+	NewModified   string          `json:"new_modified,omitempty"`
+}
+
+// TimesheetDeleteEvent represents the timesheet delete event.
+type TimesheetDeleteEvent struct {
+	Event        EventType       `json:"event,omitempty"`
+	EventFrom    string          `json:"event_from,omitempty"`
+	Referer      string          `json:"referer,omitempty"`
+	WorkspaceID  string          `json:"workspace_id,omitempty"`
+	CurrentUser  string          `json:"current_user,omitempty"`
+	ID           string          `json:"id,omitempty"`
+	OpType       string          `json:"op_type,omitempty"`
+	EntityType   tapd.EntityType `json:"entity_type,omitempty"`
+	EntityID     string          `json:"entity_id,omitempty"`
+	Secret       string          `json:"secret,omitempty"`
+	RioToken     string          `json:"rio_token,omitempty"`
+	DevProxyHost string          `json:"devproxy_host,omitempty"`
+	QueueID      string          `json:"queue_id,omitempty"`
+	EventID      string          `json:"event_id,omitempty"`
+	Created      string          `json:"created,omitempty"`
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/go-tapd/tapd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimesheetEvent_TimesheetCreateEvent(t *testing.T) {
+	var event TimesheetCreateEvent
+	loadAndParseWebhookData(t, "timesheet/create.json", &event)
+
+	assert.Equal(t, EventTypeTimesheetCreate, event.Event)
+	assert.Equal(t, "web", event.EventFrom)
+	assert.Equal(t, "https://www.tapd.cn/1112223/prong/tasks/view/1111112223001057316", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "张三", event.CurrentUser)
+	assert.Equal(t, "1111112223001130422", event.ID)
+	assert.Equal(t, tapd.EntityTypeTask, event.EntityType)
+	assert.Equal(t, "1111112223001057316", event.EntityID)
+	assert.Equal(t, "2", event.Timespent)
+	assert.Equal(t, "6", event.Timeremain)
+	assert.Equal(t, "2025-01-06", event.Spentdate)
+	assert.Equal(t, "张三", event.Owner)
+	assert.Equal(t, "接口联调", event.Memo)
+	assert.Equal(t, "319237764", event.QueueID)
+	assert.Equal(t, "183920327", event.EventID)
+	assert.Equal(t, "2025-01-06 18:20:33", event.Created)
+}
+
+func TestTimesheetEvent_TimesheetUpdateEvent(t *testing.T) {
+	var event TimesheetUpdateEvent
+	loadAndParseWebhookData(t, "timesheet/update.json", &event)
+
+	assert.Equal(t, EventTypeTimesheetUpdate, event.Event)
+	assert.Equal(t, "1111112223001130422", event.ID)
+	assert.Equal(t, "timespent,timeremain,modified", event.ChangeFields)
+	assert.Equal(t, tapd.EntityTypeTask, event.OldEntityType)
+
+	assert.Equal(t, []FieldChange{
+		{Field: "timespent", Old: "2", New: "3"},
+		{Field: "timeremain", Old: "6", New: "5"},
+		{Field: "modified", Old: "2025-01-06 18:20:33", New: "2025-01-07 09:02:15"},
+	}, event.Changes())
+}
+
+func TestTimesheetEvent_TimesheetDeleteEvent(t *testing.T) {
+	var event TimesheetDeleteEvent
+	loadAndParseWebhookData(t, "timesheet/delete.json", &event)
+
+	assert.Equal(t, EventTypeTimesheetDelete, event.Event)
+	assert.Equal(t, "1111112223001130422", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, tapd.EntityTypeTask, event.EntityType)
+	assert.Equal(t, "1111112223001057316", event.EntityID)
+	assert.Equal(t, "183922377", event.EventID)
+}
@@ -0,0 +1,75 @@
+package webhook
+
+// WikiCreateEvent represents the wiki create event.
+type WikiCreateEvent struct {
+	Event               EventType `json:"event,omitempty"`
+	EventFrom           string    `json:"event_from,omitempty"`
+	Referer             string    `json:"referer,omitempty"`
+	WorkspaceID         string    `json:"workspace_id,omitempty"`
+	CurrentUser         string    `json:"current_user,omitempty"`
+	ID                  string    `json:"id,omitempty"`
+	Name                string    `json:"name,omitempty"`
+	Description         string    `json:"description,omitempty"`
+	MarkdownDescription string    `json:"markdown_description,omitempty"`
+	ParentWikiID        string    `json:"parent_wiki_id,omitempty"`
+	Note                string    `json:"note,omitempty"`
+	Creator             string    `json:"creator,omitempty"`
+	Modifier            string    `json:"modifier,omitempty"`
+	Modified            string    `json:"modified,omitempty"`
+	Secret              string    `json:"secret,omitempty"`
+	RioToken            string    `json:"rio_token,omitempty"`
+	DevProxyHost        string    `json:"devproxy_host,omitempty"`
+	QueueID             string    `json:"queue_id,omitempty"`
+	EventID             string    `json:"event_id,omitempty"`
+	Created             string    `json:"created,omitempty"`
+}
+
+// WikiUpdateEvent represents the wiki update event.
+type WikiUpdateEvent struct {
+	Event                  EventType `json:"event,omitempty"`
+	EventFrom              string    `json:"event_from,omitempty"`
+	Referer                string    `json:"referer,omitempty"`
+	WorkspaceID            string    `json:"workspace_id,omitempty"`
+	CurrentUser            string    `json:"current_user,omitempty"`
+	ID                     string    `json:"id,omitempty"`
+	ChangeFields           string    `json:"change_fields,omitempty"`
+	Secret                 string    `json:"secret,omitempty"`
+	RioToken               string    `json:"rio_token,omitempty"`
+	DevProxyHost           string    `json:"devproxy_host,omitempty"`
+	QueueID                string    `json:"queue_id,omitempty"`
+	EventID                string    `json:"event_id,omitempty"`
+	Created                string    `json:"created,omitempty"`
+	OldName                string    `json:"old_name,omitempty"`
+	OldDescription         string    `json:"old_description,omitempty"`
+	OldMarkdownDescription string    `json:"old_markdown_description,omitempty"`
+	OldParentWikiID        string    `json:"old_parent_wiki_id,omitempty"`
+	OldNote                string    `json:"old_note,omitempty"`
+	OldCreator             string    `json:"old_creator,omitempty"`
+	OldModifier            string    `json:"old_modifier,omitempty"`
+	OldModified            string    `json:"old_modified,omitempty"`
+	NewName                string    `json:"new_name,omitempty"`
+	NewDescription         string    `json:"new_description,omitempty"`
+	NewMarkdownDescription string    `json:"new_markdown_description,omitempty"`
+	NewParentWikiID        string    `json:"new_parent_wiki_id,omitempty"`
+	NewNote                string    `json:"new_note,omitempty"`
+	NewCreator             string    `json:"new_creator,omitempty"`
+	NewModifier            string    `json:"new_modifier,omitempty"`
+	NewModified            string    `json:"new_modified,omitempty"`
+}
+
+// WikiDeleteEvent represents the wiki delete event.
+type WikiDeleteEvent struct {
+	Event        EventType `json:"event,omitempty"`
+	EventFrom    string    `json:"event_from,omitempty"`
+	Referer      string    `json:"referer,omitempty"`
+	WorkspaceID  string    `json:"workspace_id,omitempty"`
+	CurrentUser  string    `json:"current_user,omitempty"`
+	ID           string    `json:"id,omitempty"`
+	OpType       string    `json:"op_type,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	RioToken     string    `json:"rio_token,omitempty"`
+	DevProxyHost string    `json:"devproxy_host,omitempty"`
+	QueueID      string    `json:"queue_id,omitempty"`
+	EventID      string    `json:"event_id,omitempty"`
+	Created      string    `json:"created,omitempty"`
+}
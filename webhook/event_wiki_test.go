@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWikiEvent_WikiCreateEvent(t *testing.T) {
+	var event WikiCreateEvent
+	loadAndParseWebhookData(t, "wiki/create.json", &event)
+
+	assert.Equal(t, EventTypeWikiCreate, event.Event)
+	assert.Equal(t, "web", event.EventFrom)
+	assert.Equal(t, "https://www.tapd.cn/1112223/markdown_wikis/", event.Referer)
+	assert.Equal(t, "1112223", event.WorkspaceID)
+	assert.Equal(t, "张三", event.CurrentUser)
+	assert.Equal(t, "1111112223001001243", event.ID)
+	assert.Equal(t, "发布流程", event.Name)
+	assert.Equal(t, "<p>发布流程说明</p>", event.Description)
+	assert.Equal(t, "发布流程说明", event.MarkdownDescription)
+	assert.Equal(t, "1111112223001000001", event.ParentWikiID)
+	assert.Equal(t, "张三", event.Creator)
+	assert.Equal(t, "319212558", event.QueueID)
+	assert.Equal(t, "183907410", event.EventID)
+	assert.Equal(t, "2025-01-03 09:40:26", event.Created)
+}
+
+func TestWikiEvent_WikiUpdateEvent(t *testing.T) {
+	var event WikiUpdateEvent
+	loadAndParseWebhookData(t, "wiki/update.json", &event)
+
+	assert.Equal(t, EventTypeWikiUpdate, event.Event)
+	assert.Equal(t, "1111112223001001243", event.ID)
+	assert.Equal(t, "description,markdown_description,modifier,modified", event.ChangeFields)
+	assert.Equal(t, "发布流程说明", event.OldMarkdownDescription)
+	assert.Equal(t, "发布流程说明（修订）", event.NewMarkdownDescription)
+	assert.Equal(t, "张三", event.OldModifier)
+	assert.Equal(t, "李四", event.NewModifier)
+	assert.Equal(t, "183911268", event.EventID)
+}
+
+func TestWikiEvent_WikiDeleteEvent(t *testing.T) {
+	var event WikiDeleteEvent
+	loadAndParseWebhookData(t, "wiki/delete.json", &event)
+
+	assert.Equal(t, EventTypeWikiDelete, event.Event)
+	assert.Equal(t, "1111112223001001243", event.ID)
+	assert.Equal(t, "delete", event.OpType)
+	assert.Equal(t, "183911590", event.EventID)
+}
@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxBodySize is the default maximum size of a webhook payload.
+const DefaultMaxBodySize int64 = 10 << 20
+
+var (
+	// ErrMethodNotAllowed is reported when the webhook request is not a POST.
+	ErrMethodNotAllowed = errors.New("tapd: webhook method not allowed")
+
+	// ErrPayloadTooLarge is reported when the payload exceeds the max body size.
+	ErrPayloadTooLarge = errors.New("tapd: webhook payload too large")
+
+	// ErrInvalidSecret is reported when the secret or rio token carried by the
+	// event does not match the configured one.
+	ErrInvalidSecret = errors.New("tapd: webhook secret mismatch")
+)
+
+// Handler is an http.Handler receiving TAPD webhook requests and dispatching
+// them to a Dispatcher.
+//
+// Requests are answered with:
+//   - 405 for methods other than POST
+//   - 413 for payloads larger than the max body size
+//   - 400 for malformed payloads, and unsupported events in strict parse mode
+//   - 401 when the secret or rio token does not match
+//   - 500 when a listener fails, in inline mode, or the queue rejects the event
+//   - 202 once the event is accepted, in async and queue mode
+//   - 200 once the listeners succeed, in inline mode
+type Handler struct {
+	dispatcher  *Dispatcher
+	secret      string
+	rioToken    string
+	maxBodySize int64
+	async       bool
+	rejectHook  func(r *http.Request, status int, err error)
+	errorHook   func(ctx context.Context, eventType EventType, err error)
+	queue       *Queue
+
+	wg sync.WaitGroup
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+type HandlerOption func(*Handler)
+
+// WithSecret requires the secret carried by every event to match secret.
+func WithSecret(secret string) HandlerOption {
+	return func(h *Handler) {
+		h.secret = secret
+	}
+}
+
+// WithRioToken requires the rio token carried by every event to match token.
+func WithRioToken(token string) HandlerOption {
+	return func(h *Handler) {
+		h.rioToken = token
+	}
+}
+
+// WithMaxBodySize sets the maximum size of a payload, DefaultMaxBodySize by
+// default.
+func WithMaxBodySize(size int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodySize = size
+	}
+}
+
+// WithAsync acknowledges accepted events immediately and dispatches them in
+// the background, detached from the request cancellation. Use Wait to drain
+// the pending dispatches on shutdown.
+func WithAsync() HandlerOption {
+	return func(h *Handler) {
+		h.async = true
+	}
+}
+
+// WithRejectHook calls hook for every rejected request with the status code
+// answered and the reason.
+func WithRejectHook(hook func(r *http.Request, status int, err error)) HandlerOption {
+	return func(h *Handler) {
+		h.rejectHook = hook
+	}
+}
+
+// WithQueue appends accepted events to queue, which dispatches them to its own
+// dispatcher, and acknowledges them once they are durably stored. It takes
+// precedence over WithAsync.
+func WithQueue(queue *Queue) HandlerOption {
+	return func(h *Handler) {
+		h.queue = queue
+	}
+}
+
+// WithErrorHook calls hook when dispatching an accepted event fails. It is the
+// only way to observe listener errors in async mode.
+func WithErrorHook(hook func(ctx context.Context, eventType EventType, err error)) HandlerOption {
+	return func(h *Handler) {
+		h.errorHook = hook
+	}
+}
+
+// NewHandler returns a Handler dispatching to dispatcher.
+func NewHandler(dispatcher *Dispatcher, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		dispatcher:  dispatcher,
+		maxBodySize: DefaultMaxBodySize,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		h.reject(w, r, http.StatusMethodNotAllowed, ErrMethodNotAllowed)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		if maxBytesErr := (*http.MaxBytesError)(nil); errors.As(err, &maxBytesErr) {
+			h.reject(w, r, http.StatusRequestEntityTooLarge, ErrPayloadTooLarge)
+			return
+		}
+		h.reject(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.verify(payload); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrInvalidSecret) {
+			status = http.StatusUnauthorized
+		}
+		h.reject(w, r, status, err)
+		return
+	}
+
+	eventType, event, err := h.dispatcher.parse(payload)
+	if err != nil {
+		h.reject(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.queue != nil {
+		if _, err := h.queue.Enqueue(payload); err != nil {
+			h.handleError(r.Context(), eventType, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if h.async {
+		ctx := context.WithoutCancel(r.Context())
+		h.wg.Go(func() {
+			if err := h.dispatcher.Dispatch(ctx, event); err != nil {
+				h.handleError(ctx, eventType, err)
+			}
+		})
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if err := h.dispatcher.Dispatch(r.Context(), event); err != nil {
+		h.handleError(r.Context(), eventType, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Wait blocks until all events dispatched in async mode are processed.
+func (h *Handler) Wait() {
+	h.wg.Wait()
+}
+
+// verify checks the secret and rio token carried by the payload in constant
+// time.
+func (h *Handler) verify(payload []byte) error {
+	var credentials struct {
+		Secret   string `json:"secret"`
+		RioToken string `json:"rio_token"`
+	}
+	if err := json.Unmarshal(payload, &credentials); err != nil {
+		return fmt.Errorf("tapd: invalid webhook payload: %w", err)
+	}
+
+	if h.secret != "" && subtle.ConstantTimeCompare([]byte(credentials.Secret), []byte(h.secret)) != 1 {
+		return ErrInvalidSecret
+	}
+	if h.rioToken != "" && subtle.ConstantTimeCompare([]byte(credentials.RioToken), []byte(h.rioToken)) != 1 {
+		return ErrInvalidSecret
+	}
+	return nil
+}
+
+func (h *Handler) reject(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.rejectHook != nil {
+		h.rejectHook(r, status, err)
+	}
+	http.Error(w, http.StatusText(status), status)
+}
+
+func (h *Handler) handleError(ctx context.Context, eventType EventType, err error) {
+	if h.errorHook != nil {
+		h.errorHook(ctx, eventType, err)
+	}
+}
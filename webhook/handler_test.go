@@ -0,0 +1,121 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveWebhook(t *testing.T, handler http.Handler, method string, body []byte) *http.Response {
+	req := httptest.NewRequest(method, "/webhook", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	resp := w.Result()
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	var calls atomic.Int32
+	dispatcher := NewDispatcher()
+	dispatcher.RegisterStoryCreateListener(StoryCreateListenerFunc(func(ctx context.Context, event *StoryCreateEvent) error {
+		calls.Add(1)
+		assert.Equal(t, "1111112222001071295", event.ID)
+		return nil
+	}))
+
+	type rejection struct {
+		status int
+		err    error
+	}
+	var rejections []rejection
+	handler := NewHandler(dispatcher,
+		WithSecret("asdfasdfsadfasdf"),
+		WithMaxBodySize(64<<10),
+		WithRejectHook(func(r *http.Request, status int, err error) {
+			rejections = append(rejections, rejection{status, err})
+		}),
+	)
+
+	payload := loadWebhookData(t, "story/create.json")
+
+	resp := serveWebhook(t, handler, http.MethodPost, payload)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, rejections)
+
+	tests := []struct {
+		name   string
+		method string
+		body   []byte
+		status int
+		err    error
+	}{
+		{"method", http.MethodGet, nil, http.StatusMethodNotAllowed, ErrMethodNotAllowed},
+		{"too large", http.MethodPost, []byte(strings.Repeat(" ", 64<<10+1)), http.StatusRequestEntityTooLarge, ErrPayloadTooLarge},
+		{"malformed", http.MethodPost, []byte("{"), http.StatusBadRequest, nil},
+		{"secret", http.MethodPost, bytes.Replace(payload, []byte("asdfasdfsadfasdf"), []byte("wrong"), 1), http.StatusUnauthorized, ErrInvalidSecret},
+		{"unsupported", http.MethodPost, []byte(`{"event":"unknown::event","secret":"asdfasdfsadfasdf"}`), http.StatusBadRequest, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rejections = nil
+			resp := serveWebhook(t, handler, tt.method, tt.body)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			require.Len(t, rejections, 1)
+			assert.Equal(t, tt.status, rejections[0].status)
+			if tt.err != nil {
+				assert.ErrorIs(t, rejections[0].err, tt.err)
+			}
+		})
+	}
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestHandler_ServeHTTP_ListenerError(t *testing.T) {
+	errListener := errors.New("listener failed")
+	dispatcher := NewDispatcher()
+	dispatcher.RegisterStoryCreateListener(StoryCreateListenerFunc(func(context.Context, *StoryCreateEvent) error {
+		return errListener
+	}))
+
+	var hookErr error
+	handler := NewHandler(dispatcher, WithErrorHook(func(ctx context.Context, eventType EventType, err error) {
+		assert.Equal(t, EventTypeStoryCreate, eventType)
+		hookErr = err
+	}))
+
+	resp := serveWebhook(t, handler, http.MethodPost, loadWebhookData(t, "story/create.json"))
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.ErrorIs(t, hookErr, errListener)
+}
+
+func TestHandler_ServeHTTP_Async(t *testing.T) {
+	release := make(chan struct{})
+	var done atomic.Bool
+	dispatcher := NewDispatcher()
+	dispatcher.RegisterStoryCreateListener(StoryCreateListenerFunc(func(ctx context.Context, event *StoryCreateEvent) error {
+		<-release
+		assert.NoError(t, ctx.Err())
+		done.Store(true)
+		return nil
+	}))
+
+	handler := NewHandler(dispatcher, WithAsync())
+
+	resp := serveWebhook(t, handler, http.MethodPost, loadWebhookData(t, "story/create.json"))
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.False(t, done.Load())
+
+	close(release)
+	handler.Wait()
+	assert.True(t, done.Load())
+}
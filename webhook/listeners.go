@@ -1,6 +1,9 @@
 package webhook
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 // 需求/任务/缺陷类
 type (
@@ -92,3 +95,526 @@ type (
 		OnIterationDelete(ctx context.Context, event *IterationDeleteEvent) error
 	}
 )
+
+// 发布计划/发布评审
+type (
+	ReleaseCreateListener interface {
+		OnReleaseCreate(ctx context.Context, event *ReleaseCreateEvent) error
+	}
+
+	ReleaseUpdateListener interface {
+		OnReleaseUpdate(ctx context.Context, event *ReleaseUpdateEvent) error
+	}
+
+	ReleaseDeleteListener interface {
+		OnReleaseDelete(ctx context.Context, event *ReleaseDeleteEvent) error
+	}
+
+	LaunchFormCreateListener interface {
+		OnLaunchFormCreate(ctx context.Context, event *LaunchFormCreateEvent) error
+	}
+
+	LaunchFormUpdateListener interface {
+		OnLaunchFormUpdate(ctx context.Context, event *LaunchFormUpdateEvent) error
+	}
+
+	LaunchFormDeleteListener interface {
+		OnLaunchFormDelete(ctx context.Context, event *LaunchFormDeleteEvent) error
+	}
+)
+
+// 测试用例/测试计划
+type (
+	TestCaseCreateListener interface {
+		OnTestCaseCreate(ctx context.Context, event *TestCaseCreateEvent) error
+	}
+
+	TestCaseUpdateListener interface {
+		OnTestCaseUpdate(ctx context.Context, event *TestCaseUpdateEvent) error
+	}
+
+	TestCaseDeleteListener interface {
+		OnTestCaseDelete(ctx context.Context, event *TestCaseDeleteEvent) error
+	}
+
+	TestPlanCreateListener interface {
+		OnTestPlanCreate(ctx context.Context, event *TestPlanCreateEvent) error
+	}
+
+	TestPlanUpdateListener interface {
+		OnTestPlanUpdate(ctx context.Context, event *TestPlanUpdateEvent) error
+	}
+
+	TestPlanDeleteListener interface {
+		OnTestPlanDelete(ctx context.Context, event *TestPlanDeleteEvent) error
+	}
+)
+
+// Wiki
+type (
+	WikiCreateListener interface {
+		OnWikiCreate(ctx context.Context, event *WikiCreateEvent) error
+	}
+
+	WikiUpdateListener interface {
+		OnWikiUpdate(ctx context.Context, event *WikiUpdateEvent) error
+	}
+
+	WikiDeleteListener interface {
+		OnWikiDelete(ctx context.Context, event *WikiDeleteEvent) error
+	}
+)
+
+// 工时
+type (
+	TimesheetCreateListener interface {
+		OnTimesheetCreate(ctx context.Context, event *TimesheetCreateEvent) error
+	}
+
+	TimesheetUpdateListener interface {
+		OnTimesheetUpdate(ctx context.Context, event *TimesheetUpdateEvent) error
+	}
+
+	TimesheetDeleteListener interface {
+		OnTimesheetDelete(ctx context.Context, event *TimesheetDeleteEvent) error
+	}
+)
+
+// 附件
+type (
+	AttachmentAddListener interface {
+		OnAttachmentAdd(ctx context.Context, event *AttachmentAddEvent) error
+	}
+
+	AttachmentDeleteListener interface {
+		OnAttachmentDelete(ctx context.Context, event *AttachmentDeleteEvent) error
+	}
+)
+
+// AnyEventListener receives every dispatched event, such as *StoryCreateEvent,
+// including the *RawEvent of unsupported types parsed in lenient mode.
+type AnyEventListener interface {
+	OnAnyEvent(ctx context.Context, eventType EventType, event any) error
+}
+
+// Event is the set of event types listeners can be registered for with On and
+// OnFunc. Listeners of *RawEvent receive the events of unsupported types.
+type Event interface {
+	*StoryCreateEvent |
+		*StoryUpdateEvent |
+		*StoryDeleteEvent |
+		*TaskCreateEvent |
+		*TaskUpdateEvent |
+		*TaskDeleteEvent |
+		*BugCreateEvent |
+		*BugUpdateEvent |
+		*BugDeleteEvent |
+		*StoryCommentAddEvent |
+		*StoryCommentUpdateEvent |
+		*StoryCommentDeleteEvent |
+		*TaskCommentAddEvent |
+		*TaskCommentUpdateEvent |
+		*TaskCommentDeleteEvent |
+		*BugCommentAddEvent |
+		*BugCommentUpdateEvent |
+		*BugCommentDeleteEvent |
+		*IterationCreateEvent |
+		*IterationUpdateEvent |
+		*IterationDeleteEvent |
+		*ReleaseCreateEvent |
+		*ReleaseUpdateEvent |
+		*ReleaseDeleteEvent |
+		*LaunchFormCreateEvent |
+		*LaunchFormUpdateEvent |
+		*LaunchFormDeleteEvent |
+		*TestCaseCreateEvent |
+		*TestCaseUpdateEvent |
+		*TestCaseDeleteEvent |
+		*TestPlanCreateEvent |
+		*TestPlanUpdateEvent |
+		*TestPlanDeleteEvent |
+		*WikiCreateEvent |
+		*WikiUpdateEvent |
+		*WikiDeleteEvent |
+		*TimesheetCreateEvent |
+		*TimesheetUpdateEvent |
+		*TimesheetDeleteEvent |
+		*AttachmentAddEvent |
+		*AttachmentDeleteEvent |
+		*RawEvent
+}
+
+// Listener function adapters, allowing ordinary functions to be used as
+// listeners, like http.HandlerFunc.
+type (
+	StoryCreateListenerFunc func(ctx context.Context, event *StoryCreateEvent) error
+
+	StoryUpdateListenerFunc func(ctx context.Context, event *StoryUpdateEvent) error
+
+	StoryDeleteListenerFunc func(ctx context.Context, event *StoryDeleteEvent) error
+
+	TaskCreateListenerFunc func(ctx context.Context, event *TaskCreateEvent) error
+
+	TaskUpdateListenerFunc func(ctx context.Context, event *TaskUpdateEvent) error
+
+	TaskDeleteListenerFunc func(ctx context.Context, event *TaskDeleteEvent) error
+
+	BugCreateListenerFunc func(ctx context.Context, event *BugCreateEvent) error
+
+	BugUpdateListenerFunc func(ctx context.Context, event *BugUpdateEvent) error
+
+	BugDeleteListenerFunc func(ctx context.Context, event *BugDeleteEvent) error
+
+	StoryCommentAddListenerFunc func(ctx context.Context, event *StoryCommentAddEvent) error
+
+	StoryCommentUpdateListenerFunc func(ctx context.Context, event *StoryCommentUpdateEvent) error
+
+	StoryCommentDeleteListenerFunc func(ctx context.Context, event *StoryCommentDeleteEvent) error
+
+	TaskCommentAddListenerFunc func(ctx context.Context, event *TaskCommentAddEvent) error
+
+	TaskCommentUpdateListenerFunc func(ctx context.Context, event *TaskCommentUpdateEvent) error
+
+	TaskCommentDeleteListenerFunc func(ctx context.Context, event *TaskCommentDeleteEvent) error
+
+	BugCommentAddListenerFunc func(ctx context.Context, event *BugCommentAddEvent) error
+
+	BugCommentUpdateListenerFunc func(ctx context.Context, event *BugCommentUpdateEvent) error
+
+	BugCommentDeleteListenerFunc func(ctx context.Context, event *BugCommentDeleteEvent) error
+
+	IterationCreateListenerFunc func(ctx context.Context, event *IterationCreateEvent) error
+
+	IterationUpdateListenerFunc func(ctx context.Context, event *IterationUpdateEvent) error
+
+	IterationDeleteListenerFunc func(ctx context.Context, event *IterationDeleteEvent) error
+
+	ReleaseCreateListenerFunc func(ctx context.Context, event *ReleaseCreateEvent) error
+
+	ReleaseUpdateListenerFunc func(ctx context.Context, event *ReleaseUpdateEvent) error
+
+	ReleaseDeleteListenerFunc func(ctx context.Context, event *ReleaseDeleteEvent) error
+
+	LaunchFormCreateListenerFunc func(ctx context.Context, event *LaunchFormCreateEvent) error
+
+	LaunchFormUpdateListenerFunc func(ctx context.Context, event *LaunchFormUpdateEvent) error
+
+	LaunchFormDeleteListenerFunc func(ctx context.Context, event *LaunchFormDeleteEvent) error
+
+	TestCaseCreateListenerFunc func(ctx context.Context, event *TestCaseCreateEvent) error
+
+	TestCaseUpdateListenerFunc func(ctx context.Context, event *TestCaseUpdateEvent) error
+
+	TestCaseDeleteListenerFunc func(ctx context.Context, event *TestCaseDeleteEvent) error
+
+	TestPlanCreateListenerFunc func(ctx context.Context, event *TestPlanCreateEvent) error
+
+	TestPlanUpdateListenerFunc func(ctx context.Context, event *TestPlanUpdateEvent) error
+
+	TestPlanDeleteListenerFunc func(ctx context.Context, event *TestPlanDeleteEvent) error
+
+	WikiCreateListenerFunc func(ctx context.Context, event *WikiCreateEvent) error
+
+	WikiUpdateListenerFunc func(ctx context.Context, event *WikiUpdateEvent) error
+
+	WikiDeleteListenerFunc func(ctx context.Context, event *WikiDeleteEvent) error
+
+	TimesheetCreateListenerFunc func(ctx context.Context, event *TimesheetCreateEvent) error
+
+	TimesheetUpdateListenerFunc func(ctx context.Context, event *TimesheetUpdateEvent) error
+
+	TimesheetDeleteListenerFunc func(ctx context.Context, event *TimesheetDeleteEvent) error
+
+	AttachmentAddListenerFunc func(ctx context.Context, event *AttachmentAddEvent) error
+
+	AttachmentDeleteListenerFunc func(ctx context.Context, event *AttachmentDeleteEvent) error
+
+	AnyEventListenerFunc func(ctx context.Context, eventType EventType, event any) error
+)
+
+func (f StoryCreateListenerFunc) OnStoryCreate(ctx context.Context, event *StoryCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f StoryUpdateListenerFunc) OnStoryUpdate(ctx context.Context, event *StoryUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f StoryDeleteListenerFunc) OnStoryDelete(ctx context.Context, event *StoryDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskCreateListenerFunc) OnTaskCreate(ctx context.Context, event *TaskCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskUpdateListenerFunc) OnTaskUpdate(ctx context.Context, event *TaskUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskDeleteListenerFunc) OnTaskDelete(ctx context.Context, event *TaskDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugCreateListenerFunc) OnBugCreate(ctx context.Context, event *BugCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugUpdateListenerFunc) OnBugUpdate(ctx context.Context, event *BugUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugDeleteListenerFunc) OnBugDelete(ctx context.Context, event *BugDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f StoryCommentAddListenerFunc) OnStoryCommentAdd(ctx context.Context, event *StoryCommentAddEvent) error {
+	return f(ctx, event)
+}
+
+func (f StoryCommentUpdateListenerFunc) OnStoryCommentUpdate(ctx context.Context, event *StoryCommentUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f StoryCommentDeleteListenerFunc) OnStoryCommentDelete(ctx context.Context, event *StoryCommentDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskCommentAddListenerFunc) OnTaskCommentAdd(ctx context.Context, event *TaskCommentAddEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskCommentUpdateListenerFunc) OnTaskCommentUpdate(ctx context.Context, event *TaskCommentUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TaskCommentDeleteListenerFunc) OnTaskCommentDelete(ctx context.Context, event *TaskCommentDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugCommentAddListenerFunc) OnBugCommentAdd(ctx context.Context, event *BugCommentAddEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugCommentUpdateListenerFunc) OnBugCommentUpdate(ctx context.Context, event *BugCommentUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f BugCommentDeleteListenerFunc) OnBugCommentDelete(ctx context.Context, event *BugCommentDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f IterationCreateListenerFunc) OnIterationCreate(ctx context.Context, event *IterationCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f IterationUpdateListenerFunc) OnIterationUpdate(ctx context.Context, event *IterationUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f IterationDeleteListenerFunc) OnIterationDelete(ctx context.Context, event *IterationDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f ReleaseCreateListenerFunc) OnReleaseCreate(ctx context.Context, event *ReleaseCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f ReleaseUpdateListenerFunc) OnReleaseUpdate(ctx context.Context, event *ReleaseUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f ReleaseDeleteListenerFunc) OnReleaseDelete(ctx context.Context, event *ReleaseDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f LaunchFormCreateListenerFunc) OnLaunchFormCreate(ctx context.Context, event *LaunchFormCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f LaunchFormUpdateListenerFunc) OnLaunchFormUpdate(ctx context.Context, event *LaunchFormUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f LaunchFormDeleteListenerFunc) OnLaunchFormDelete(ctx context.Context, event *LaunchFormDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestCaseCreateListenerFunc) OnTestCaseCreate(ctx context.Context, event *TestCaseCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestCaseUpdateListenerFunc) OnTestCaseUpdate(ctx context.Context, event *TestCaseUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestCaseDeleteListenerFunc) OnTestCaseDelete(ctx context.Context, event *TestCaseDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestPlanCreateListenerFunc) OnTestPlanCreate(ctx context.Context, event *TestPlanCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestPlanUpdateListenerFunc) OnTestPlanUpdate(ctx context.Context, event *TestPlanUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TestPlanDeleteListenerFunc) OnTestPlanDelete(ctx context.Context, event *TestPlanDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f WikiCreateListenerFunc) OnWikiCreate(ctx context.Context, event *WikiCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f WikiUpdateListenerFunc) OnWikiUpdate(ctx context.Context, event *WikiUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f WikiDeleteListenerFunc) OnWikiDelete(ctx context.Context, event *WikiDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f TimesheetCreateListenerFunc) OnTimesheetCreate(ctx context.Context, event *TimesheetCreateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TimesheetUpdateListenerFunc) OnTimesheetUpdate(ctx context.Context, event *TimesheetUpdateEvent) error {
+	return f(ctx, event)
+}
+
+func (f TimesheetDeleteListenerFunc) OnTimesheetDelete(ctx context.Context, event *TimesheetDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f AttachmentAddListenerFunc) OnAttachmentAdd(ctx context.Context, event *AttachmentAddEvent) error {
+	return f(ctx, event)
+}
+
+func (f AttachmentDeleteListenerFunc) OnAttachmentDelete(ctx context.Context, event *AttachmentDeleteEvent) error {
+	return f(ctx, event)
+}
+
+func (f AnyEventListenerFunc) OnAnyEvent(ctx context.Context, eventType EventType, event any) error {
+	return f(ctx, eventType, event)
+}
+
+// OnFunc adapts fn to the listener interface of the event type E, for use with
+// Registers or WithRegisters.
+//
+//	webhook.NewDispatcher(webhook.WithRegisters(
+//		webhook.OnFunc(func(ctx context.Context, event *webhook.StoryUpdateEvent) error {
+//			return nil
+//		}),
+//	))
+func OnFunc[E Event](fn func(ctx context.Context, event E) error) any {
+	switch fn := any(fn).(type) {
+	case func(context.Context, *StoryCreateEvent) error:
+		return StoryCreateListenerFunc(fn)
+	case func(context.Context, *StoryUpdateEvent) error:
+		return StoryUpdateListenerFunc(fn)
+	case func(context.Context, *StoryDeleteEvent) error:
+		return StoryDeleteListenerFunc(fn)
+	case func(context.Context, *TaskCreateEvent) error:
+		return TaskCreateListenerFunc(fn)
+	case func(context.Context, *TaskUpdateEvent) error:
+		return TaskUpdateListenerFunc(fn)
+	case func(context.Context, *TaskDeleteEvent) error:
+		return TaskDeleteListenerFunc(fn)
+	case func(context.Context, *BugCreateEvent) error:
+		return BugCreateListenerFunc(fn)
+	case func(context.Context, *BugUpdateEvent) error:
+		return BugUpdateListenerFunc(fn)
+	case func(context.Context, *BugDeleteEvent) error:
+		return BugDeleteListenerFunc(fn)
+	case func(context.Context, *StoryCommentAddEvent) error:
+		return StoryCommentAddListenerFunc(fn)
+	case func(context.Context, *StoryCommentUpdateEvent) error:
+		return StoryCommentUpdateListenerFunc(fn)
+	case func(context.Context, *StoryCommentDeleteEvent) error:
+		return StoryCommentDeleteListenerFunc(fn)
+	case func(context.Context, *TaskCommentAddEvent) error:
+		return TaskCommentAddListenerFunc(fn)
+	case func(context.Context, *TaskCommentUpdateEvent) error:
+		return TaskCommentUpdateListenerFunc(fn)
+	case func(context.Context, *TaskCommentDeleteEvent) error:
+		return TaskCommentDeleteListenerFunc(fn)
+	case func(context.Context, *BugCommentAddEvent) error:
+		return BugCommentAddListenerFunc(fn)
+	case func(context.Context, *BugCommentUpdateEvent) error:
+		return BugCommentUpdateListenerFunc(fn)
+	case func(context.Context, *BugCommentDeleteEvent) error:
+		return BugCommentDeleteListenerFunc(fn)
+	case func(context.Context, *IterationCreateEvent) error:
+		return IterationCreateListenerFunc(fn)
+	case func(context.Context, *IterationUpdateEvent) error:
+		return IterationUpdateListenerFunc(fn)
+	case func(context.Context, *IterationDeleteEvent) error:
+		return IterationDeleteListenerFunc(fn)
+	case func(context.Context, *ReleaseCreateEvent) error:
+		return ReleaseCreateListenerFunc(fn)
+	case func(context.Context, *ReleaseUpdateEvent) error:
+		return ReleaseUpdateListenerFunc(fn)
+	case func(context.Context, *ReleaseDeleteEvent) error:
+		return ReleaseDeleteListenerFunc(fn)
+	case func(context.Context, *LaunchFormCreateEvent) error:
+		return LaunchFormCreateListenerFunc(fn)
+	case func(context.Context, *LaunchFormUpdateEvent) error:
+		return LaunchFormUpdateListenerFunc(fn)
+	case func(context.Context, *LaunchFormDeleteEvent) error:
+		return LaunchFormDeleteListenerFunc(fn)
+	case func(context.Context, *TestCaseCreateEvent) error:
+		return TestCaseCreateListenerFunc(fn)
+	case func(context.Context, *TestCaseUpdateEvent) error:
+		return TestCaseUpdateListenerFunc(fn)
+	case func(context.Context, *TestCaseDeleteEvent) error:
+		return TestCaseDeleteListenerFunc(fn)
+	case func(context.Context, *TestPlanCreateEvent) error:
+		return TestPlanCreateListenerFunc(fn)
+	case func(context.Context, *TestPlanUpdateEvent) error:
+		return TestPlanUpdateListenerFunc(fn)
+	case func(context.Context, *TestPlanDeleteEvent) error:
+		return TestPlanDeleteListenerFunc(fn)
+	case func(context.Context, *WikiCreateEvent) error:
+		return WikiCreateListenerFunc(fn)
+	case func(context.Context, *WikiUpdateEvent) error:
+		return WikiUpdateListenerFunc(fn)
+	case func(context.Context, *WikiDeleteEvent) error:
+		return WikiDeleteListenerFunc(fn)
+	case func(context.Context, *TimesheetCreateEvent) error:
+		return TimesheetCreateListenerFunc(fn)
+	case func(context.Context, *TimesheetUpdateEvent) error:
+		return TimesheetUpdateListenerFunc(fn)
+	case func(context.Context, *TimesheetDeleteEvent) error:
+		return TimesheetDeleteListenerFunc(fn)
+	case func(context.Context, *AttachmentAddEvent) error:
+		return AttachmentAddListenerFunc(fn)
+	case func(context.Context, *AttachmentDeleteEvent) error:
+		return AttachmentDeleteListenerFunc(fn)
+	case func(context.Context, *RawEvent) error:
+		return AnyEventListenerFunc(func(ctx context.Context, _ EventType, event any) error {
+			if raw, ok := event.(*RawEvent); ok {
+				return fn(ctx, raw)
+			}
+			return nil
+		})
+	default:
+		panic(fmt.Sprintf("tapd: webhook event %T not supported", *new(E)))
+	}
+}
+
+// On registers fn as a listener of the event type E.
+//
+// Unlike Registers, On cannot be given a value matching no listener: OnFunc
+// adapts fn to the listener of E for every Event type. It panics if that ever
+// stops holding, like OnFunc does for an unsupported event type.
+//
+//	webhook.On(dispatcher, func(ctx context.Context, event *webhook.StoryUpdateEvent) error {
+//		return nil
+//	})
+func On[E Event](d *Dispatcher, fn func(ctx context.Context, event E) error) {
+	if !d.register(OnFunc(fn), 0) {
+		panic(fmt.Sprintf("tapd: webhook event %T not registered", *new(E)))
+	}
+}
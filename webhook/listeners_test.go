@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOn(t *testing.T) {
+	dispatcher := NewDispatcher()
+
+	var storyUpdates, bugCreates int
+	On(dispatcher, func(ctx context.Context, event *StoryUpdateEvent) error {
+		storyUpdates++
+		assert.Equal(t, EventTypeStoryUpdate, event.Event)
+		return nil
+	})
+	On(dispatcher, func(ctx context.Context, event *BugCreateEvent) error {
+		bugCreates++
+		return nil
+	})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/update.json")))
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "bug/create.json")))
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "task/create.json")))
+	assert.Equal(t, 1, storyUpdates)
+	assert.Equal(t, 1, bugCreates)
+}
+
+func TestOnFunc(t *testing.T) {
+	var called bool
+	listener := OnFunc(func(ctx context.Context, event *IterationDeleteEvent) error {
+		called = true
+		return nil
+	})
+	require.IsType(t, IterationDeleteListenerFunc(nil), listener)
+
+	dispatcher := NewDispatcher(WithRegisters(listener))
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "iteration/delete.json")))
+	assert.True(t, called)
+}
+
+func TestDispatcher_Registers(t *testing.T) {
+	dispatcher := NewDispatcher()
+
+	err := dispatcher.Registers(&testListener{t: t}, "not a listener", 42)
+	assert.EqualError(t, err, "tapd: webhook listener string implements no listener interface\n"+
+		"tapd: webhook listener int implements no listener interface")
+	assert.Len(t, dispatcher.storyCreateListeners, 1)
+
+	assert.NotPanics(t, func() {
+		NewDispatcher(WithRegisters(struct{}{}))
+	})
+}
+
+func onFuncListener[E Event]() any {
+	return OnFunc(func(context.Context, E) error { return nil })
+}
+
+func TestOnFunc_Registers(t *testing.T) {
+	// every listener built by OnFunc, and so by On, is registered
+	assert.NoError(t, NewDispatcher().Registers(
+		onFuncListener[*StoryCreateEvent](),
+		onFuncListener[*StoryUpdateEvent](),
+		onFuncListener[*StoryDeleteEvent](),
+		onFuncListener[*TaskCreateEvent](),
+		onFuncListener[*TaskUpdateEvent](),
+		onFuncListener[*TaskDeleteEvent](),
+		onFuncListener[*BugCreateEvent](),
+		onFuncListener[*BugUpdateEvent](),
+		onFuncListener[*BugDeleteEvent](),
+		onFuncListener[*StoryCommentAddEvent](),
+		onFuncListener[*StoryCommentUpdateEvent](),
+		onFuncListener[*StoryCommentDeleteEvent](),
+		onFuncListener[*TaskCommentAddEvent](),
+		onFuncListener[*TaskCommentUpdateEvent](),
+		onFuncListener[*TaskCommentDeleteEvent](),
+		onFuncListener[*BugCommentAddEvent](),
+		onFuncListener[*BugCommentUpdateEvent](),
+		onFuncListener[*BugCommentDeleteEvent](),
+		onFuncListener[*IterationCreateEvent](),
+		onFuncListener[*IterationUpdateEvent](),
+		onFuncListener[*IterationDeleteEvent](),
+		onFuncListener[*ReleaseCreateEvent](),
+		onFuncListener[*ReleaseUpdateEvent](),
+		onFuncListener[*ReleaseDeleteEvent](),
+		onFuncListener[*LaunchFormCreateEvent](),
+		onFuncListener[*LaunchFormUpdateEvent](),
+		onFuncListener[*LaunchFormDeleteEvent](),
+		onFuncListener[*TestCaseCreateEvent](),
+		onFuncListener[*TestCaseUpdateEvent](),
+		onFuncListener[*TestCaseDeleteEvent](),
+		onFuncListener[*TestPlanCreateEvent](),
+		onFuncListener[*TestPlanUpdateEvent](),
+		onFuncListener[*TestPlanDeleteEvent](),
+		onFuncListener[*WikiCreateEvent](),
+		onFuncListener[*WikiUpdateEvent](),
+		onFuncListener[*WikiDeleteEvent](),
+		onFuncListener[*TimesheetCreateEvent](),
+		onFuncListener[*TimesheetUpdateEvent](),
+		onFuncListener[*TimesheetDeleteEvent](),
+		onFuncListener[*AttachmentAddEvent](),
+		onFuncListener[*AttachmentDeleteEvent](),
+		onFuncListener[*RawEvent](),
+	))
+}
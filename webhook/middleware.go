@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+// Invocation describes a single listener invocation passed through the
+// middleware chain.
+type Invocation struct {
+	EventType EventType // 事件类型
+	EventID   string    // 事件ID，同一事件重复推送时不变
+	ObjectID  string    // 业务对象ID，如需求ID
+	Event     any       // 事件，如 *StoryCreateEvent
+	Listener  any       // 被调用的监听器
+}
+
+// Middleware wraps a listener invocation. It calls next to continue the chain
+// and may alter the context, inspect the error or skip the listener entirely.
+type Middleware func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error
+
+// Use appends the middlewares to the chain applied to every listener
+// invocation. The first middleware is the outermost one.
+func (d *Dispatcher) Use(middlewares ...Middleware) {
+	d.middlewares = append(d.middlewares, middlewares...)
+}
+
+// invoke calls the listener through the middleware chain.
+func (d *Dispatcher) invoke(ctx context.Context, invocation *Invocation, call func(ctx context.Context) error) error {
+	next := call
+	for i := len(d.middlewares) - 1; i >= 0; i-- {
+		middleware, inner := d.middlewares[i], next
+		next = func(ctx context.Context) error {
+			return middleware(ctx, invocation, inner)
+		}
+	}
+	return next(ctx)
+}
+
+// PanicError is returned by the Recovery middleware when a listener panics.
+type PanicError struct {
+	Value any    // 传给 panic 的值
+	Stack []byte // 发生 panic 的调用栈
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("tapd: webhook listener panic: %v\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the value passed to panic if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Recovery converts a panic raised by the listener into a *PanicError carrying
+// the stack, so that a faulty listener does not crash the server.
+func Recovery() Middleware {
+	return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) (err error) {
+		defer func() {
+			if value := recover(); value != nil {
+				if panicErr, ok := value.(*PanicError); ok {
+					err = panicErr
+					return
+				}
+				err = &PanicError{Value: value, Stack: debug.Stack()}
+			}
+		}()
+		return next(ctx)
+	}
+}
+
+// Timeout cancels the context of the listener after timeout. The invocation
+// returns an error wrapping context.DeadlineExceeded as soon as the timeout
+// expires, even if the listener ignores the context and keeps running.
+//
+// A panic raised by the listener is re-raised as a *PanicError to the outer
+// middlewares, so Recovery still applies when it is placed before Timeout.
+func Timeout(timeout time.Duration) Middleware {
+	return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		type result struct {
+			err   error
+			panic *PanicError
+		}
+		done := make(chan result, 1)
+		go func() {
+			defer func() {
+				if value := recover(); value != nil {
+					done <- result{panic: &PanicError{Value: value, Stack: debug.Stack()}}
+				}
+			}()
+			done <- result{err: next(ctx)}
+		}()
+
+		select {
+		case res := <-done:
+			if res.panic != nil {
+				panic(res.panic)
+			}
+			return res.err
+		case <-ctx.Done():
+			return fmt.Errorf("tapd: webhook listener %T timed out after %s: %w", invocation.Listener, timeout, context.Cause(ctx))
+		}
+	}
+}
+
+// Logging logs every invocation to logger with the event type, event ID,
+// object ID, listener and duration, at debug level on success and at error level on
+// failure. A nil logger uses slog.Default().
+func Logging(logger *slog.Logger) Middleware {
+	return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error {
+		l := logger
+		if l == nil {
+			l = slog.Default()
+		}
+
+		start := time.Now()
+		err := next(ctx)
+		attrs := []slog.Attr{
+			slog.String("event_type", string(invocation.EventType)),
+			slog.String("event_id", invocation.EventID),
+			slog.String("object_id", invocation.ObjectID),
+			slog.String("listener", fmt.Sprintf("%T", invocation.Listener)),
+			slog.Duration("duration", time.Since(start)),
+		}
+		if err != nil {
+			l.LogAttrs(ctx, slog.LevelError, "webhook listener failed", append(attrs, slog.Any("error", err))...)
+		} else {
+			l.LogAttrs(ctx, slog.LevelDebug, "webhook listener succeeded", attrs...)
+		}
+		return err
+	}
+}
+
+// MetricsHooks are the callbacks of the Metrics middleware. Nil hooks are
+// skipped.
+type MetricsHooks struct {
+	// OnStart is called before the listener is invoked.
+	OnStart func(ctx context.Context, invocation *Invocation)
+
+	// OnFinish is called once the listener returns with its duration and error.
+	OnFinish func(ctx context.Context, invocation *Invocation, duration time.Duration, err error)
+}
+
+// Metrics reports every invocation to the hooks, e.g. to feed counters and
+// latency histograms.
+func Metrics(hooks MetricsHooks) Middleware {
+	return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error {
+		if hooks.OnStart != nil {
+			hooks.OnStart(ctx, invocation)
+		}
+		start := time.Now()
+		err := next(ctx)
+		if hooks.OnFinish != nil {
+			hooks.OnFinish(ctx, invocation, time.Since(start), err)
+		}
+		return err
+	}
+}
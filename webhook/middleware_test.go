@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatcher_Use(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error {
+			order = append(order, name+":before")
+			err := next(ctx)
+			order = append(order, name+":after")
+			return err
+		}
+	}
+
+	dispatcher := NewDispatcher(WithMiddlewares(record("outer")))
+	dispatcher.Use(record("inner"))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		order = append(order, "listener")
+		return nil
+	})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")))
+	assert.Equal(t, []string{"outer:before", "inner:before", "listener", "inner:after", "outer:after"}, order)
+}
+
+func TestRecovery(t *testing.T) {
+	dispatcher := NewDispatcher(WithMiddlewares(Recovery()))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		panic("boom")
+	})
+
+	err := dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json"))
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "boom", panicErr.Value)
+	assert.Contains(t, string(panicErr.Stack), "TestRecovery")
+	assert.Contains(t, err.Error(), "tapd: webhook listener panic: boom")
+
+	errPanic := errors.New("panic error")
+	dispatcher = NewDispatcher(WithMiddlewares(Recovery()))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		panic(errPanic)
+	})
+	assert.ErrorIs(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")), errPanic)
+}
+
+func TestTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	dispatcher := NewDispatcher(WithMiddlewares(Timeout(10 * time.Millisecond)))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		<-release // ignores the context
+		return nil
+	})
+	err := dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	dispatcher = NewDispatcher(WithMiddlewares(Recovery(), Timeout(time.Second)))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok)
+		panic("boom")
+	})
+	var panicErr *PanicError
+	err = dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json"))
+	require.ErrorAs(t, err, &panicErr)
+	assert.Contains(t, string(panicErr.Stack), "TestTimeout")
+}
+
+func TestLogging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	errListener := errors.New("listener failed")
+	dispatcher := NewDispatcher(WithMiddlewares(Logging(logger)))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		return errListener
+	})
+
+	err := dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json"))
+	assert.ErrorIs(t, err, errListener)
+	assert.Contains(t, buf.String(), "level=ERROR")
+	assert.Contains(t, buf.String(), "event_type=story::create")
+	assert.Contains(t, buf.String(), "event_id=1687744222")
+	assert.Contains(t, buf.String(), "object_id=1111112222001071295")
+	assert.Contains(t, buf.String(), "listener=webhook.StoryCreateListenerFunc")
+	assert.Contains(t, buf.String(), `error="listener failed"`)
+}
+
+func TestMetrics(t *testing.T) {
+	var started, finished atomic.Int32
+	var lastErr error
+	dispatcher := NewDispatcher(WithMiddlewares(Metrics(MetricsHooks{
+		OnStart: func(ctx context.Context, invocation *Invocation) {
+			started.Add(1)
+			assert.Equal(t, EventTypeStoryCreate, invocation.EventType)
+			assert.IsType(t, &StoryCreateEvent{}, invocation.Event)
+		},
+		OnFinish: func(ctx context.Context, invocation *Invocation, duration time.Duration, err error) {
+			finished.Add(1)
+			assert.GreaterOrEqual(t, duration, time.Duration(0))
+			lastErr = err
+		},
+	})))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		return nil
+	})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")))
+	assert.Equal(t, int32(1), started.Load())
+	assert.Equal(t, int32(1), finished.Load())
+	assert.NoError(t, lastErr)
+}
@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-tapd/tapd"
+)
+
+// Predicate reports whether an event, such as *StoryUpdateEvent, matches.
+// Predicates are combined with And, Or and Not, and applied with Where or
+// Filter.
+//
+//	webhook.On(dispatcher, webhook.Where(
+//		webhook.And(
+//			webhook.InWorkspace(11112222),
+//			webhook.StatusTransition("", "resolved"),
+//		),
+//		func(ctx context.Context, event *webhook.BugUpdateEvent) error {
+//			return nil
+//		},
+//	))
+type Predicate func(event any) bool
+
+// Where returns a listener function calling fn only for the events matching
+// the predicate.
+func Where[E Event](predicate Predicate, fn func(ctx context.Context, event E) error) func(ctx context.Context, event E) error {
+	return func(ctx context.Context, event E) error {
+		if !predicate(event) {
+			return nil
+		}
+		return fn(ctx, event)
+	}
+}
+
+// Filter is a middleware skipping the listeners for the events not matching
+// the predicate.
+func Filter(predicate Predicate) Middleware {
+	return func(ctx context.Context, invocation *Invocation, next func(ctx context.Context) error) error {
+		if !predicate(invocation.Event) {
+			return nil
+		}
+		return next(ctx)
+	}
+}
+
+// And matches when all the predicates match, or when there is none.
+func And(predicates ...Predicate) Predicate {
+	return func(event any) bool {
+		for _, predicate := range predicates {
+			if !predicate(event) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or matches when any of the predicates matches.
+func Or(predicates ...Predicate) Predicate {
+	return func(event any) bool {
+		for _, predicate := range predicates {
+			if predicate(event) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not matches when the predicate does not.
+func Not(predicate Predicate) Predicate {
+	return func(event any) bool {
+		return !predicate(event)
+	}
+}
+
+// InWorkspace matches the events of any of the workspaces.
+func InWorkspace(workspaceIDs ...int) Predicate {
+	ids := make([]string, 0, len(workspaceIDs))
+	for _, id := range workspaceIDs {
+		ids = append(ids, strconv.Itoa(id))
+	}
+	return func(event any) bool {
+		return slices.Contains(ids, eventString(event, "workspace_id"))
+	}
+}
+
+// CreatedBy matches the events of objects created by any of the users: the
+// creator of stories, tasks and iterations, the reporter of bugs and the
+// author of comments.
+func CreatedBy(users ...string) Predicate {
+	return func(event any) bool {
+		creator := eventString(event, "creator", "reporter", "author", "old_creator", "old_reporter")
+		return creator != "" && slices.Contains(users, creator)
+	}
+}
+
+// TriggeredBy matches the events triggered by any of the users, the current
+// user of the event.
+func TriggeredBy(users ...string) Predicate {
+	return func(event any) bool {
+		return slices.Contains(users, eventString(event, "current_user"))
+	}
+}
+
+// FieldChanged matches the update events changing any of the fields, custom
+// fields included.
+func FieldChanged(fields ...string) Predicate {
+	return func(event any) bool {
+		for _, field := range splitChangeFields(eventString(event, "change_fields")) {
+			if slices.Contains(fields, field) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// StatusTransition matches the update events changing the status from the
+// status from to the status to. An empty status matches any status.
+func StatusTransition(from, to string) Predicate {
+	return func(event any) bool {
+		if !hasChangeField(eventString(event, "change_fields"), "status") {
+			return false
+		}
+		return (from == "" || eventString(event, "old_status") == from) &&
+			(to == "" || eventString(event, "new_status") == to)
+	}
+}
+
+// OfEventType matches the events of any of the event types.
+func OfEventType(eventTypes ...EventType) Predicate {
+	return func(event any) bool {
+		return slices.Contains(eventTypes, EventType(eventString(event, "event")))
+	}
+}
+
+// OfEntityType matches the events on any of the entity types, the part of the
+// event type before "::", such as story, bug or story_comment.
+func OfEntityType(entityTypes ...tapd.EntityType) Predicate {
+	return func(event any) bool {
+		entityType, _, _ := strings.Cut(eventString(event, "event"), "::")
+		return slices.Contains(entityTypes, tapd.EntityType(entityType))
+	}
+}
+
+// eventString returns the value of the first non-empty field of the event
+// struct, or key of the raw event, with one of the JSON names.
+func eventString(event any, names ...string) string {
+	if raw, ok := event.(*RawEvent); ok {
+		for _, name := range names {
+			if value := raw.String(name); value != "" {
+				return value
+			}
+		}
+		return ""
+	}
+
+	v := reflect.ValueOf(event)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	v = v.Elem()
+
+	index := eventFieldIndex(v.Type())
+	for _, name := range names {
+		if i, ok := index[name]; ok {
+			if value := stringifyField(v.Field(i)); value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
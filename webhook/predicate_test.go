@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-tapd/tapd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicates(t *testing.T) {
+	var storyCreate StoryCreateEvent
+	loadAndParseWebhookData(t, "story/create.json", &storyCreate)
+	var storyUpdate StoryUpdateEvent
+	loadAndParseWebhookData(t, "story/update.json", &storyUpdate)
+	var bugCreate BugCreateEvent
+	loadAndParseWebhookData(t, "bug/create.json", &bugCreate)
+	var commentAdd StoryCommentAddEvent
+	loadAndParseWebhookData(t, "story_comment/add.json", &commentAdd)
+
+	resolved := &BugUpdateEvent{ChangeFields: "status,modified", OldStatus: "new", NewStatus: "resolved"}
+
+	tests := []struct {
+		name      string
+		predicate Predicate
+		event     any
+		want      bool
+	}{
+		{"workspace", InWorkspace(1, 11112222), &storyCreate, true},
+		{"other workspace", InWorkspace(1), &storyCreate, false},
+		{"creator", CreatedBy("creator"), &storyCreate, true},
+		{"reporter", CreatedBy("张三"), &bugCreate, true},
+		{"author", CreatedBy("张三"), &commentAdd, true},
+		{"no creator", CreatedBy(""), &storyUpdate, false},
+		{"current user", TriggeredBy("张三"), &storyUpdate, true},
+		{"other current user", TriggeredBy("李四"), &storyUpdate, false},
+		{"field changed", FieldChanged("status", "owner"), &storyUpdate, true},
+		{"field not changed", FieldChanged("status"), &storyUpdate, false},
+		{"field of create event", FieldChanged("name"), &storyCreate, false},
+		{"status transition", StatusTransition("new", "resolved"), resolved, true},
+		{"status transition to", StatusTransition("", "resolved"), resolved, true},
+		{"status transition from", StatusTransition("open", ""), resolved, false},
+		{"status not changed", StatusTransition("audited", ""), &storyUpdate, false},
+		{"event type", OfEventType(EventTypeStoryUpdate), &storyUpdate, true},
+		{"other event type", OfEventType(EventTypeStoryCreate), &storyUpdate, false},
+		{"entity type", OfEntityType(tapd.EntityTypeStory), &storyUpdate, true},
+		{"comment entity type", OfEntityType(tapd.EntityTypeStory), &commentAdd, false},
+		{"and", And(InWorkspace(11112222), TriggeredBy("张三")), &storyUpdate, true},
+		{"and mismatch", And(InWorkspace(11112222), TriggeredBy("李四")), &storyUpdate, false},
+		{"empty and", And(), &storyUpdate, true},
+		{"or", Or(TriggeredBy("李四"), FieldChanged("owner")), &storyUpdate, true},
+		{"empty or", Or(), &storyUpdate, false},
+		{"not", Not(InWorkspace(11112222)), &storyUpdate, false},
+		{"nil event", InWorkspace(0), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.predicate(tt.event))
+		})
+	}
+}
+
+func TestWhere(t *testing.T) {
+	var calls int
+	dispatcher := NewDispatcher()
+	On(dispatcher, Where(FieldChanged("owner"), func(ctx context.Context, event *StoryUpdateEvent) error {
+		calls++
+		return nil
+	}))
+	On(dispatcher, Where(FieldChanged("status"), func(ctx context.Context, event *StoryUpdateEvent) error {
+		calls += 10
+		return nil
+	}))
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/update.json")))
+	assert.Equal(t, 1, calls)
+}
+
+func TestFilter(t *testing.T) {
+	var calls int
+	dispatcher := NewDispatcher(WithMiddlewares(Filter(InWorkspace(11112222))))
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		calls++
+		return nil
+	})
+	On(dispatcher, func(ctx context.Context, event *BugCreateEvent) error {
+		calls += 10
+		return nil
+	})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")))
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "bug/create.json")))
+	assert.Equal(t, 1, calls)
+}
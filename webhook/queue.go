@@ -0,0 +1,531 @@
+package webhook
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultQueueWorkers is the default number of queue workers.
+	DefaultQueueWorkers = 4
+
+	// DefaultQueueMaxAttempts is the default number of attempts before an event
+	// is moved to the dead-letter directory.
+	DefaultQueueMaxAttempts = 5
+
+	queueLogName    = "wal.log"
+	queueDeadDir    = "dead"
+	queueMinBackoff = time.Second
+	queueMaxBackoff = 5 * time.Minute
+)
+
+// ErrQueueClosed is returned when enqueuing into a closed Queue.
+var ErrQueueClosed = errors.New("tapd: webhook queue closed")
+
+// DeadLetter is an event that failed all its attempts.
+type DeadLetter struct {
+	ID       string          `json:"id"`        // 队列中的事件ID
+	Attempts int             `json:"attempts"`  // 已尝试次数
+	Error    string          `json:"error"`     // 最后一次失败的原因
+	FailedAt time.Time       `json:"failed_at"` // 最后一次失败的时间
+	Payload  json.RawMessage `json:"payload"`   // 原始事件
+}
+
+// Queue is a durable layer between a Handler and a Dispatcher. Payloads are
+// appended to a write-ahead log in the queue directory before being
+// acknowledged, then dispatched by workers retrying failures with an
+// exponential backoff. Events failing all their attempts are moved to the
+// "dead" subdirectory, from which Replay resubmits them.
+//
+// Events still pending on Close, or on a crash, are dispatched again when the
+// queue is reopened, so listeners must tolerate duplicates, see WithDedup.
+// Attempts are counted in memory and start over after a restart.
+//
+// A directory must be used by a single Queue at a time.
+type Queue struct {
+	dispatcher  *Dispatcher
+	dir         string
+	workers     int
+	maxAttempts int
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	errorHook   func(ctx context.Context, id string, attempt int, err error)
+	deadHook    func(ctx context.Context, letter *DeadLetter)
+
+	mu      sync.Mutex
+	log     *os.File
+	lastID  int64
+	pending int
+	ready   []*queueItem
+	timers  map[string]*time.Timer
+	closed  bool
+	signal  chan struct{}
+	ctx     context.Context // canceled by Close
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+type queueItem struct {
+	id       string
+	payload  json.RawMessage
+	attempts int
+}
+
+type queueRecord struct {
+	Op      string          `json:"op"` // add or done
+	ID      string          `json:"id"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type QueueOption func(*Queue)
+
+// WithQueueWorkers sets the number of events dispatched concurrently,
+// DefaultQueueWorkers by default.
+func WithQueueWorkers(workers int) QueueOption {
+	return func(q *Queue) {
+		q.workers = workers
+	}
+}
+
+// WithQueueMaxAttempts sets the number of attempts before an event is moved to
+// the dead-letter directory, DefaultQueueMaxAttempts by default.
+func WithQueueMaxAttempts(attempts int) QueueOption {
+	return func(q *Queue) {
+		q.maxAttempts = attempts
+	}
+}
+
+// WithQueueBackoff sets the delay before the first retry, doubled on every
+// following retry up to max. It is 1s up to 5m by default.
+func WithQueueBackoff(min, max time.Duration) QueueOption {
+	return func(q *Queue) {
+		q.minBackoff = min
+		q.maxBackoff = max
+	}
+}
+
+// WithQueueErrorHook calls hook for every failed attempt.
+func WithQueueErrorHook(hook func(ctx context.Context, id string, attempt int, err error)) QueueOption {
+	return func(q *Queue) {
+		q.errorHook = hook
+	}
+}
+
+// WithQueueDeadLetterHook calls hook for every event moved to the dead-letter
+// directory.
+func WithQueueDeadLetterHook(hook func(ctx context.Context, letter *DeadLetter)) QueueOption {
+	return func(q *Queue) {
+		q.deadHook = hook
+	}
+}
+
+// NewQueue opens the queue in dir, creating it if needed, and starts the
+// workers dispatching to dispatcher. Events left pending by a previous run are
+// dispatched again.
+func NewQueue(dispatcher *Dispatcher, dir string, opts ...QueueOption) (*Queue, error) {
+	q := &Queue{
+		dispatcher:  dispatcher,
+		dir:         dir,
+		workers:     DefaultQueueWorkers,
+		maxAttempts: DefaultQueueMaxAttempts,
+		minBackoff:  queueMinBackoff,
+		maxBackoff:  queueMaxBackoff,
+		timers:      make(map[string]*time.Timer),
+		signal:      make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	q.workers = max(q.workers, 1)
+	q.maxAttempts = max(q.maxAttempts, 1)
+	q.ctx, q.cancel = context.WithCancel(context.Background())
+
+	if err := os.MkdirAll(filepath.Join(dir, queueDeadDir), 0o755); err != nil {
+		return nil, err
+	}
+	if err := q.recover(); err != nil {
+		q.cancel()
+		return nil, err
+	}
+
+	for range q.workers {
+		q.wg.Go(q.work)
+	}
+	q.notify()
+	return q, nil
+}
+
+// Enqueue durably appends the payload to the queue and returns its ID. The
+// payload must be a JSON object.
+func (q *Queue) Enqueue(payload []byte) (string, error) {
+	if !json.Valid(payload) {
+		return "", errors.New("tapd: invalid webhook payload")
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return "", ErrQueueClosed
+	}
+
+	item := &queueItem{id: q.nextID(), payload: json.RawMessage(payload)}
+	if err := q.write(queueRecord{Op: "add", ID: item.id, Payload: item.payload}, true); err != nil {
+		return "", err
+	}
+	q.pending++
+	q.ready = append(q.ready, item)
+	q.notify()
+	return item.id, nil
+}
+
+// Len returns the number of events not yet dispatched successfully nor moved
+// to the dead-letter directory.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.pending
+}
+
+// DeadLetters returns the events in the dead-letter directory, oldest first.
+func (q *Queue) DeadLetters() ([]*DeadLetter, error) {
+	entries, err := os.ReadDir(filepath.Join(q.dir, queueDeadDir))
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]*DeadLetter, 0, len(entries))
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		letter, err := q.readDeadLetter(id)
+		if err != nil {
+			return nil, err
+		}
+		letters = append(letters, letter)
+	}
+	return letters, nil
+}
+
+// Replay moves the event from the dead-letter directory back to the queue and
+// returns its new ID.
+func (q *Queue) Replay(id string) (string, error) {
+	letter, err := q.readDeadLetter(id)
+	if err != nil {
+		return "", err
+	}
+
+	newID, err := q.Enqueue(letter.Payload)
+	if err != nil {
+		return "", err
+	}
+	if err := os.Remove(q.deadLetterPath(id)); err != nil {
+		return newID, err
+	}
+	return newID, nil
+}
+
+// ReplayAll moves all the events from the dead-letter directory back to the
+// queue and returns their number.
+func (q *Queue) ReplayAll() (int, error) {
+	letters, err := q.DeadLetters()
+	if err != nil {
+		return 0, err
+	}
+
+	for i, letter := range letters {
+		if _, err := q.Replay(letter.ID); err != nil {
+			return i, err
+		}
+	}
+	return len(letters), nil
+}
+
+// Close stops accepting events, cancels the context of the events being
+// dispatched and waits for their listeners to return. Pending events,
+// including the ones interrupted by Close, are kept in the log and dispatched
+// when the queue is reopened.
+func (q *Queue) Close() error {
+	q.mu.Lock()
+	if q.closed {
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	for _, timer := range q.timers {
+		timer.Stop()
+	}
+	q.cancel()
+	q.mu.Unlock()
+
+	q.wg.Wait()
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.log.Close()
+}
+
+// work dispatches ready events until the queue is closed.
+func (q *Queue) work() {
+	for {
+		select {
+		case <-q.ctx.Done():
+			return
+		default:
+		}
+
+		q.mu.Lock()
+		var item *queueItem
+		if len(q.ready) > 0 {
+			item = q.ready[0]
+			q.ready = q.ready[1:]
+		}
+		if len(q.ready) > 0 {
+			q.notify()
+		}
+		q.mu.Unlock()
+
+		if item == nil {
+			select {
+			case <-q.ctx.Done():
+				return
+			case <-q.signal:
+			}
+			continue
+		}
+		q.process(item)
+	}
+}
+
+// process dispatches the item once and records the outcome.
+func (q *Queue) process(item *queueItem) {
+	ctx := q.ctx
+	item.attempts++
+
+	_, event, err := q.dispatcher.parse(item.payload)
+	if err != nil {
+		// unsupported or malformed events never succeed
+		q.fail(ctx, item, err)
+		q.bury(ctx, item, err)
+		return
+	}
+
+	if err := q.dispatcher.Dispatch(ctx, event); err != nil {
+		if ctx.Err() != nil {
+			// interrupted by Close, the event stays in the log
+			return
+		}
+		q.fail(ctx, item, err)
+		if item.attempts >= q.maxAttempts {
+			q.bury(ctx, item, err)
+			return
+		}
+		q.retry(item)
+		return
+	}
+	q.done(item)
+}
+
+func (q *Queue) fail(ctx context.Context, item *queueItem, err error) {
+	if q.errorHook != nil {
+		q.errorHook(ctx, item.id, item.attempts, err)
+	}
+}
+
+// retry schedules the item after the backoff of its attempts.
+func (q *Queue) retry(item *queueItem) {
+	delay := q.minBackoff << min(item.attempts-1, 30)
+	if delay <= 0 || delay > q.maxBackoff {
+		delay = q.maxBackoff
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.timers[item.id] = time.AfterFunc(delay, func() {
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		delete(q.timers, item.id)
+		if q.closed {
+			return
+		}
+		q.ready = append(q.ready, item)
+		q.notify()
+	})
+}
+
+// bury moves the item to the dead-letter directory.
+func (q *Queue) bury(ctx context.Context, item *queueItem, err error) {
+	letter := &DeadLetter{
+		ID:       item.id,
+		Attempts: item.attempts,
+		Error:    err.Error(),
+		FailedAt: time.Now(),
+		Payload:  item.payload,
+	}
+	data, marshalErr := json.MarshalIndent(letter, "", "  ")
+	if marshalErr == nil {
+		marshalErr = os.WriteFile(q.deadLetterPath(item.id), data, 0o644)
+	}
+	if marshalErr != nil {
+		// keep the event in the log, it is retried on the next run
+		if q.errorHook != nil {
+			q.errorHook(ctx, item.id, item.attempts, fmt.Errorf("tapd: webhook queue dead letter [%s]: %w", item.id, marshalErr))
+		}
+		return
+	}
+
+	q.done(item)
+	if q.deadHook != nil {
+		q.deadHook(ctx, letter)
+	}
+}
+
+// done records the item as no longer pending.
+func (q *Queue) done(item *queueItem) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pending--
+	if q.pending == 0 {
+		// nothing left to recover, start the log over
+		if err := q.log.Truncate(0); err == nil {
+			return
+		}
+	}
+	_ = q.write(queueRecord{Op: "done", ID: item.id}, false)
+}
+
+// write appends the record to the log, syncing it to disk if required.
+func (q *Queue) write(record queueRecord, sync bool) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := q.log.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	if sync {
+		return q.log.Sync()
+	}
+	return nil
+}
+
+// recover loads the pending events from the log and rewrites it with them
+// only. A truncated last record, left by a crash while appending, is dropped.
+func (q *Queue) recover() error {
+	path := filepath.Join(q.dir, queueLogName)
+
+	var items []*queueItem
+	if file, err := os.Open(path); err == nil {
+		index := make(map[string]int)
+		reader := bufio.NewReader(file)
+		var readErr error
+		for readErr == nil {
+			// records are read whole, whatever the payload size
+			var line []byte
+			line, readErr = reader.ReadBytes('\n')
+			if len(line) == 0 {
+				continue
+			}
+			var record queueRecord
+			if err := json.Unmarshal(line, &record); err != nil {
+				break
+			}
+			switch record.Op {
+			case "add":
+				index[record.ID] = len(items)
+				items = append(items, &queueItem{id: record.ID, payload: record.Payload})
+			case "done":
+				if i, ok := index[record.ID]; ok {
+					items[i] = nil
+				}
+			}
+			if id, err := strconv.ParseInt(record.ID, 10, 64); err == nil {
+				q.lastID = max(q.lastID, id)
+			}
+		}
+		_ = file.Close()
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return readErr
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	items = slices.DeleteFunc(items, func(item *queueItem) bool { return item == nil })
+
+	tmp := path + ".tmp"
+	log, err := os.OpenFile(tmp, os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	q.log = log
+	for _, item := range items {
+		if err := q.write(queueRecord{Op: "add", ID: item.id, Payload: item.payload}, false); err != nil {
+			_ = log.Close()
+			return err
+		}
+	}
+	if err := log.Sync(); err != nil {
+		_ = log.Close()
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = log.Close()
+		return err
+	}
+
+	q.pending = len(items)
+	q.ready = items
+	return nil
+}
+
+// nextID returns an ID greater than all the previous ones, even across
+// restarts, so that IDs sort in enqueue order.
+func (q *Queue) nextID() string {
+	q.lastID = max(q.lastID+1, time.Now().UnixNano())
+	return strconv.FormatInt(q.lastID, 10)
+}
+
+// notify wakes up a waiting worker.
+func (q *Queue) notify() {
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (q *Queue) readDeadLetter(id string) (*DeadLetter, error) {
+	if id == "" || filepath.Base(id) != id {
+		return nil, fmt.Errorf("tapd: invalid dead letter id [%s]", id)
+	}
+
+	data, err := os.ReadFile(q.deadLetterPath(id))
+	if err != nil {
+		return nil, err
+	}
+	var letter DeadLetter
+	if err := json.Unmarshal(data, &letter); err != nil {
+		return nil, err
+	}
+	return &letter, nil
+}
+
+func (q *Queue) deadLetterPath(id string) string {
+	return filepath.Join(q.dir, queueDeadDir, id+".json")
+}
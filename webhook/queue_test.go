@@ -0,0 +1,227 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	var calls atomic.Int32
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		calls.Add(1)
+		return nil
+	})
+
+	queue, err := NewQueue(dispatcher, t.TempDir())
+	require.NoError(t, err)
+
+	id, err := queue.Enqueue(loadWebhookData(t, "story/create.json"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	assert.Eventually(t, func() bool { return queue.Len() == 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+
+	require.NoError(t, queue.Close())
+	_, err = queue.Enqueue(loadWebhookData(t, "story/create.json"))
+	assert.ErrorIs(t, err, ErrQueueClosed)
+}
+
+func TestQueue_DeadLetter(t *testing.T) {
+	var (
+		fail     atomic.Bool
+		calls    atomic.Int32
+		failures atomic.Int32
+		buried   = make(chan *DeadLetter, 2)
+	)
+	fail.Store(true)
+	errListener := errors.New("listener failed")
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		calls.Add(1)
+		if fail.Load() {
+			return errListener
+		}
+		return nil
+	})
+
+	queue, err := NewQueue(dispatcher, t.TempDir(),
+		WithQueueWorkers(2),
+		WithQueueMaxAttempts(3),
+		WithQueueBackoff(time.Millisecond, 5*time.Millisecond),
+		WithQueueErrorHook(func(ctx context.Context, id string, attempt int, err error) {
+			failures.Add(1)
+		}),
+		WithQueueDeadLetterHook(func(ctx context.Context, letter *DeadLetter) {
+			buried <- letter
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = queue.Close() })
+
+	id, err := queue.Enqueue(loadWebhookData(t, "story/create.json"))
+	require.NoError(t, err)
+	_, err = queue.Enqueue([]byte(`{"event":"unknown::event"}`))
+	require.NoError(t, err)
+
+	letter := <-buried
+	if letter.ID != id {
+		letter = <-buried
+	}
+	assert.Equal(t, id, letter.ID)
+	assert.Equal(t, 3, letter.Attempts)
+	assert.Equal(t, "listener failed", letter.Error)
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, int32(4), failures.Load())
+	assert.Equal(t, 0, queue.Len())
+
+	letters, err := queue.DeadLetters()
+	require.NoError(t, err)
+	require.Len(t, letters, 2)
+	assert.Equal(t, id, letters[0].ID)
+	assert.JSONEq(t, string(loadWebhookData(t, "story/create.json")), string(letters[0].Payload))
+
+	fail.Store(false)
+	_, err = queue.Replay(id)
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return queue.Len() == 0 && calls.Load() == 4 }, time.Second, time.Millisecond)
+
+	letters, err = queue.DeadLetters()
+	require.NoError(t, err)
+	assert.Len(t, letters, 1)
+
+	_, err = queue.Replay("../wal.log")
+	assert.Error(t, err)
+}
+
+func TestQueue_Recover(t *testing.T) {
+	dir := t.TempDir()
+	payload := loadWebhookData(t, "story/create.json")
+	record := func(op, id string) string {
+		data, err := json.Marshal(queueRecord{Op: op, ID: id, Payload: payload})
+		require.NoError(t, err)
+		return string(data) + "\n"
+	}
+	done, err := json.Marshal(queueRecord{Op: "done", ID: "1"})
+	require.NoError(t, err)
+	wal := record("add", "1") + record("add", "2") + string(done) + "\n" + `{"op":"add","id":"3","pay`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, queueLogName), []byte(wal), 0o644))
+
+	var ids []string
+	called := make(chan struct{}, 1)
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		ids = append(ids, event.ID)
+		called <- struct{}{}
+		return nil
+	})
+
+	queue, err := NewQueue(dispatcher, dir, WithQueueWorkers(1))
+	require.NoError(t, err)
+	<-called
+	assert.Eventually(t, func() bool { return queue.Len() == 0 }, time.Second, time.Millisecond)
+	require.NoError(t, queue.Close())
+	assert.Len(t, ids, 1)
+
+	data, err := os.ReadFile(filepath.Join(dir, queueLogName))
+	require.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestQueue_Recover_LargePayload(t *testing.T) {
+	dir := t.TempDir()
+	var event map[string]any
+	require.NoError(t, json.Unmarshal(loadWebhookData(t, "story/create.json"), &event))
+	event["description"] = strings.Repeat("a", int(DefaultMaxBodySize)*2)
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	queue, err := NewQueue(NewDispatcher(), dir, WithQueueWorkers(1), WithQueueBackoff(time.Hour, time.Hour))
+	require.NoError(t, err)
+	// fail the first attempt so the event stays in the log
+	queue.dispatcher.RegisterStoryCreateListener(StoryCreateListenerFunc(func(context.Context, *StoryCreateEvent) error {
+		return errors.New("failed")
+	}))
+	_, err = queue.Enqueue(payload)
+	require.NoError(t, err)
+	require.NoError(t, queue.Close())
+
+	called := make(chan int, 1)
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		called <- len(event.Description)
+		return nil
+	})
+	queue, err = NewQueue(dispatcher, dir, WithQueueWorkers(1))
+	require.NoError(t, err)
+	assert.Equal(t, int(DefaultMaxBodySize)*2, <-called)
+	require.NoError(t, queue.Close())
+}
+
+func TestQueue_Close(t *testing.T) {
+	dir := t.TempDir()
+	started := make(chan struct{})
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	var attempts []int
+	queue, err := NewQueue(dispatcher, dir, WithQueueWorkers(1), WithQueueMaxAttempts(1),
+		WithQueueErrorHook(func(ctx context.Context, id string, attempt int, err error) {
+			attempts = append(attempts, attempt)
+		}),
+	)
+	require.NoError(t, err)
+	_, err = queue.Enqueue(loadWebhookData(t, "story/create.json"))
+	require.NoError(t, err)
+	<-started
+	require.NoError(t, queue.Close())
+	assert.Empty(t, attempts)
+
+	letters, err := queue.DeadLetters()
+	require.NoError(t, err)
+	assert.Empty(t, letters)
+
+	called := make(chan struct{}, 1)
+	dispatcher = NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		called <- struct{}{}
+		return nil
+	})
+	queue, err = NewQueue(dispatcher, dir, WithQueueWorkers(1))
+	require.NoError(t, err)
+	<-called
+	require.NoError(t, queue.Close())
+}
+
+func TestHandler_ServeHTTP_Queue(t *testing.T) {
+	called := make(chan *StoryCreateEvent, 1)
+	dispatcher := NewDispatcher()
+	On(dispatcher, func(ctx context.Context, event *StoryCreateEvent) error {
+		called <- event
+		return nil
+	})
+	queue, err := NewQueue(dispatcher, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = queue.Close() })
+
+	handler := NewHandler(dispatcher, WithQueue(queue))
+	resp := serveWebhook(t, handler, http.MethodPost, loadWebhookData(t, "story/create.json"))
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, "1111112222001071295", (<-called).ID)
+}
@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Strategy runs the listener calls of an event and reports their error. The
+// calls are ordered by listener priority, highest first.
+type Strategy func(ctx context.Context, calls []func(ctx context.Context) error) error
+
+// FailFast runs the calls concurrently and cancels the context of the others
+// on the first error, which is returned. It is the default strategy.
+func FailFast() Strategy {
+	return Bounded(0)
+}
+
+// CollectAll runs the calls concurrently to completion and returns all their
+// errors joined with errors.Join.
+func CollectAll() Strategy {
+	return func(ctx context.Context, calls []func(ctx context.Context) error) error {
+		errs := make([]error, len(calls))
+		var wg sync.WaitGroup
+		for i, call := range calls {
+			wg.Go(func() {
+				errs[i] = call(ctx)
+			})
+		}
+		wg.Wait()
+		return errors.Join(errs...)
+	}
+}
+
+// Sequential runs the calls one after another in priority order and stops at
+// the first error, so that a listener is only invoked once all the listeners
+// before it succeeded.
+func Sequential() Strategy {
+	return func(ctx context.Context, calls []func(ctx context.Context) error) error {
+		for _, call := range calls {
+			if err := call(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Bounded is like FailFast but runs at most limit calls at once, starting them
+// in priority order. A limit less than or equal to zero means no limit.
+func Bounded(limit int) Strategy {
+	return func(ctx context.Context, calls []func(ctx context.Context) error) error {
+		eg, ctx := errgroup.WithContext(ctx)
+		if limit > 0 {
+			eg.SetLimit(limit)
+		}
+		for _, call := range calls {
+			eg.Go(func() error {
+				return call(ctx)
+			})
+		}
+		return eg.Wait()
+	}
+}
+
+// Prioritized is implemented by listeners with a priority. Listeners with a
+// higher priority are run first, listeners with the same priority in
+// registration order. Listeners not implementing it have priority 0.
+type Prioritized interface {
+	Priority() int
+}
+
+type listenerEntry[L any] struct {
+	listener L
+	priority int
+}
+
+// listenerPriority returns the priority reported by the listener, 0 if none.
+func listenerPriority(listener any) int {
+	if p, ok := listener.(Prioritized); ok {
+		return p.Priority()
+	}
+	return 0
+}
+
+// addListeners adds the listeners with their own priority.
+func addListeners[L any](entries []listenerEntry[L], listeners ...L) []listenerEntry[L] {
+	for _, listener := range listeners {
+		entries = addListener(entries, listener, listenerPriority(listener))
+	}
+	return entries
+}
+
+// addListener inserts the listener after all the entries with a higher or equal
+// priority.
+func addListener[L any](entries []listenerEntry[L], listener L, priority int) []listenerEntry[L] {
+	i := slices.IndexFunc(entries, func(entry listenerEntry[L]) bool {
+		return entry.priority < priority
+	})
+	if i < 0 {
+		i = len(entries)
+	}
+	return slices.Insert(entries, i, listenerEntry[L]{listener: listener, priority: priority})
+}
@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type priorityListener struct {
+	name     string
+	priority int
+	order    *[]string
+}
+
+func (l *priorityListener) Priority() int {
+	return l.priority
+}
+
+func (l *priorityListener) OnStoryCreate(ctx context.Context, event *StoryCreateEvent) error {
+	*l.order = append(*l.order, l.name)
+	return nil
+}
+
+func TestDispatcher_Priority(t *testing.T) {
+	var order []string
+	dispatcher := NewDispatcher(WithStrategy(Sequential()))
+	require.NoError(t, dispatcher.Registers(
+		&priorityListener{name: "notify", order: &order},
+		&priorityListener{name: "audit", priority: 10, order: &order},
+		&priorityListener{name: "metrics", order: &order},
+	))
+	require.NoError(t, dispatcher.RegistersWithPriority(5, StoryCreateListenerFunc(func(context.Context, *StoryCreateEvent) error {
+		order = append(order, "enrich")
+		return nil
+	})))
+	dispatcher.RegisterStoryCreateListener(&priorityListener{name: "cleanup", priority: -1, order: &order})
+
+	require.NoError(t, dispatcher.DispatchPayload(t.Context(), loadWebhookData(t, "story/create.json")))
+	assert.Equal(t, []string{"audit", "enrich", "notify", "metrics", "cleanup"}, order)
+}
+
+func TestSequential(t *testing.T) {
+	errFirst := errors.New("first failed")
+	var secondCalled bool
+	err := Sequential()(t.Context(), []func(ctx context.Context) error{
+		func(context.Context) error { return errFirst },
+		func(context.Context) error { secondCalled = true; return nil },
+	})
+	assert.ErrorIs(t, err, errFirst)
+	assert.False(t, secondCalled)
+}
+
+func TestFailFast(t *testing.T) {
+	errFirst := errors.New("first failed")
+	err := FailFast()(t.Context(), []func(ctx context.Context) error{
+		func(context.Context) error { return errFirst },
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+	assert.ErrorIs(t, err, errFirst)
+}
+
+func TestCollectAll(t *testing.T) {
+	errFirst, errSecond := errors.New("first failed"), errors.New("second failed")
+	var thirdCalled atomic.Bool
+	err := CollectAll()(t.Context(), []func(ctx context.Context) error{
+		func(context.Context) error { return errFirst },
+		func(context.Context) error { return errSecond },
+		func(ctx context.Context) error {
+			assert.NoError(t, ctx.Err())
+			thirdCalled.Store(true)
+			return nil
+		},
+	})
+	assert.ErrorIs(t, err, errFirst)
+	assert.ErrorIs(t, err, errSecond)
+	assert.True(t, thirdCalled.Load())
+}
+
+func TestBounded(t *testing.T) {
+	var (
+		mu              sync.Mutex
+		running, maxRun int
+	)
+	calls := make([]func(ctx context.Context) error, 10)
+	for i := range calls {
+		calls[i] = func(context.Context) error {
+			mu.Lock()
+			running++
+			maxRun = max(maxRun, running)
+			mu.Unlock()
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		}
+	}
+
+	require.NoError(t, Bounded(2)(t.Context(), calls))
+	assert.LessOrEqual(t, maxRun, 2)
+	assert.GreaterOrEqual(t, maxRun, 1)
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-tapd/tapd"
+)
+
+// WorkItem returns the created story as a work item.
+func (e *StoryCreateEvent) WorkItem() tapd.WorkItem {
+	return &tapd.Story{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Name:         e.Name,
+		Owner:        e.Owner,
+		Status:       e.Status,
+		IterationID:  e.IterationID,
+		Begin:        new(e.Begin),
+		Due:          new(e.Due),
+		Priority:     e.Priority,
+		Creator:      e.Creator,
+		Created:      e.Created,
+		CustomFields: e.CustomFields,
+	}
+}
+
+// WorkItem returns the updated story as a work item.
+//
+// Fields listed in change_fields take their new value, the others their old value.
+func (e *StoryUpdateEvent) WorkItem() tapd.WorkItem {
+	changed := strings.Split(e.ChangeFields, ",")
+	return &tapd.Story{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Name:         changedValue(changed, "name", e.OldName, e.NewName),
+		Owner:        changedValue(changed, "owner", e.OldOwner, e.NewOwner),
+		Status:       changedValue(changed, "status", e.OldStatus, e.NewStatus),
+		IterationID:  changedValue(changed, "iteration_id", e.OldIterationID, e.NewIterationID),
+		Begin:        new(changedValue(changed, "begin", e.OldBegin, e.NewBegin)),
+		Due:          new(changedValue(changed, "due", e.OldDue, e.NewDue)),
+		Effort:       new(changedValue(changed, "effort", e.OldEffort, e.NewEffort)),
+		Priority:     changedValue(changed, "priority", e.OldPriority, e.NewPriority),
+		Created:      e.OldCreated,
+		Modified:     changedValue(changed, "modified", e.OldModified, e.NewModified),
+		CustomFields: changedCustomFields(changed, e.OldCustomFields, e.NewCustomFields),
+	}
+}
+
+// WorkItem returns the created bug as a work item.
+func (e *BugCreateEvent) WorkItem() tapd.WorkItem {
+	return &tapd.Bug{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Title:        e.Title,
+		CurrentOwner: e.CurrentOwner,
+		Status:       e.Status,
+		IterationID:  e.IterationID,
+		Begin:        e.Begin,
+		Due:          e.Due,
+		Priority:     e.Priority,
+		Severity:     e.Severity,
+		Reporter:     e.Reporter,
+		Created:      e.Created,
+		CustomFields: e.CustomFields,
+	}
+}
+
+// WorkItem returns the updated bug as a work item.
+//
+// Fields listed in change_fields take their new value, the others their old value.
+func (e *BugUpdateEvent) WorkItem() tapd.WorkItem {
+	changed := strings.Split(e.ChangeFields, ",")
+	return &tapd.Bug{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Title:        changedValue(changed, "title", e.OldTitle, e.NewTitle),
+		CurrentOwner: changedValue(changed, "current_owner", e.OldCurrentOwner, e.NewCurrentOwner),
+		Status:       changedValue(changed, "status", e.OldStatus, e.NewStatus),
+		IterationID:  changedValue(changed, "iteration_id", e.OldIterationID, e.NewIterationID),
+		Begin:        changedValue(changed, "begin", e.OldBegin, e.NewBegin),
+		Due:          changedValue(changed, "due", e.OldDue, e.NewDue),
+		Effort:       changedValue(changed, "effort", e.OldEffort, e.NewEffort),
+		Priority:     changedValue(changed, "priority", e.OldPriority, e.NewPriority),
+		Created:      e.OldCreated,
+		Modified:     changedValue(changed, "modified", e.OldModified, e.NewModified),
+		CustomFields: changedCustomFields(changed, e.OldCustomFields, e.NewCustomFields),
+	}
+}
+
+// WorkItem returns the created task as a work item.
+func (e *TaskCreateEvent) WorkItem() tapd.WorkItem {
+	return &tapd.Task{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Name:         e.Name,
+		Owner:        e.Owner,
+		Status:       e.Status,
+		IterationID:  e.IterationID,
+		Effort:       e.Effort,
+		Created:      e.Created,
+		CustomFields: e.CustomFields,
+	}
+}
+
+// WorkItem returns the updated task as a work item.
+//
+// Fields listed in change_fields take their new value, the others their old value.
+func (e *TaskUpdateEvent) WorkItem() tapd.WorkItem {
+	changed := strings.Split(e.ChangeFields, ",")
+	return &tapd.Task{
+		ID:           e.ID,
+		WorkspaceID:  e.WorkspaceID,
+		Name:         changedValue(changed, "name", e.OldName, e.NewName),
+		Owner:        changedValue(changed, "owner", e.OldOwner, e.NewOwner),
+		Status:       changedValue(changed, "status", e.OldStatus, e.NewStatus),
+		IterationID:  changedValue(changed, "iteration_id", e.OldIterationID, e.NewIterationID),
+		Begin:        changedValue(changed, "begin", e.OldBegin, e.NewBegin),
+		Due:          changedValue(changed, "due", e.OldDue, e.NewDue),
+		Effort:       changedValue(changed, "effort", e.OldEffort, e.NewEffort),
+		Priority:     changedValue(changed, "priority", e.OldPriority, e.NewPriority),
+		Created:      e.OldCreated,
+		Modified:     changedValue(changed, "modified", e.OldModified, e.NewModified),
+		CustomFields: changedCustomFields(changed, e.OldCustomFields, e.NewCustomFields),
+	}
+}
+
+func changedValue[T any](changed []string, field string, oldValue, newValue T) T {
+	if slices.Contains(changed, field) {
+		return newValue
+	}
+	return oldValue
+}
+
+func changedCustomFields(changed []string, oldFields, newFields tapd.CustomFields) tapd.CustomFields {
+	fields := maps.Clone(oldFields)
+	for field, value := range newFields {
+		if !slices.Contains(changed, field) {
+			continue
+		}
+		if fields == nil {
+			fields = make(tapd.CustomFields)
+		}
+		fields[field] = value
+	}
+	return fields
+}
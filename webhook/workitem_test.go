@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/go-tapd/tapd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkItem_StoryCreateEvent(t *testing.T) {
+	var event StoryCreateEvent
+	loadAndParseWebhookData(t, "story/create.json", &event)
+
+	item := event.WorkItem()
+	assert.Equal(t, tapd.EntityTypeStory, item.GetEntityType())
+	assert.Equal(t, "1111112222001071295", item.GetID())
+	assert.Equal(t, "11112222", item.GetWorkspaceID())
+	assert.Equal(t, "asdfasdfasdfasdfasdf", item.GetName())
+	assert.Equal(t, string(tapd.StoryStatusPlanning), item.GetStatus())
+	assert.Equal(t, "1111112222001001246", item.GetIterationID())
+}
+
+func TestWorkItem_StoryUpdateEvent(t *testing.T) {
+	var event StoryUpdateEvent
+	loadAndParseWebhookData(t, "story/update.json", &event)
+
+	item := event.WorkItem()
+	assert.Equal(t, tapd.EntityTypeStory, item.GetEntityType())
+	assert.Equal(t, "1111112222001069123", item.GetID())
+	assert.Equal(t, "11112222", item.GetWorkspaceID())
+	assert.Equal(t, "old name", item.GetName())
+	assert.Equal(t, "new owner", item.GetOwner())
+	assert.Equal(t, string(tapd.StoryStatusAudited), item.GetStatus())
+	assert.Equal(t, "2024-08-27 18:07:00", item.GetModified())
+	assert.Equal(t, tapd.CustomFieldValue("old custom field 98"), item.GetCustomFields().Get("custom_field_98"))
+}
+
+func TestWorkItem_BugUpdateEvent(t *testing.T) {
+	var event BugUpdateEvent
+	loadAndParseWebhookData(t, "bug/update.json", &event)
+
+	item := event.WorkItem()
+	assert.Equal(t, tapd.EntityTypeBug, item.GetEntityType())
+	assert.Equal(t, "11111222333001039910", item.GetID())
+	assert.Equal(t, "111222333", item.GetWorkspaceID())
+	assert.Equal(t, "123222", item.GetName())
+	assert.Equal(t, "new", item.GetStatus())
+	assert.Equal(t, "2024-12-30 18:25:09", item.GetModified())
+}
+
+func TestWorkItem_TaskCreateEvent(t *testing.T) {
+	var event TaskCreateEvent
+	loadAndParseWebhookData(t, "task/create.json", &event)
+
+	item := event.WorkItem()
+	assert.Equal(t, tapd.EntityTypeTask, item.GetEntityType())
+	assert.Equal(t, "1111122233001116469", item.GetID())
+	assert.Equal(t, "任务合并-数据开发", item.GetName())
+	assert.Equal(t, "张三;", item.GetOwner())
+	assert.Equal(t, string(tapd.TaskStatusOpen), item.GetStatus())
+}
+
+func TestWorkItem_ChangedCustomFields(t *testing.T) {
+	fields := changedCustomFields(
+		[]string{"custom_field_2"},
+		tapd.CustomFields{"custom_field_1": "a", "custom_field_2": "b"},
+		tapd.CustomFields{"custom_field_2": "c", "custom_field_3": "d"},
+	)
+	assert.Equal(t, tapd.CustomFields{"custom_field_1": "a", "custom_field_2": "c"}, fields)
+}
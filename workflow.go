@@ -0,0 +1,123 @@
+package tapd
+
+import "context"
+
+// Workflow combines the statuses, transitions and parallel nodes of the
+// workflow of a work item type.
+type Workflow struct {
+	Scope         WorkflowScope           // 所属项目及业务对象类型
+	States        *WorkflowStates         // 状态，含起始与结束状态
+	Transitions   []*WorkflowTransition   // 流转规则
+	ParallelNodes []*WorkflowParallelNode // 并行工作节点，仅需求有效
+}
+
+// LoadWorkflow loads the workflow of the scope.
+//
+// Tasks have no configurable workflow, their fixed statuses may transition
+// freely between each other.
+func LoadWorkflow(ctx context.Context, client *Client, scope WorkflowScope, opts ...RequestOption) (*Workflow, error) {
+	states, err := loadWorkflowStates(ctx, client, scope, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := &Workflow{
+		Scope:  scope,
+		States: states,
+	}
+	if scope.EntityType == EntityTypeTask {
+		workflow.Transitions = completeTransitions(states)
+		return workflow, nil
+	}
+
+	var workitemTypeID *int64
+	if scope.WorkitemTypeID != 0 {
+		workitemTypeID = new(scope.WorkitemTypeID)
+	}
+
+	workflow.Transitions, _, err = client.WorkflowService.GetWorkflowTransitions(ctx, &GetWorkflowTransitionsRequest{
+		WorkspaceID:    new(scope.WorkspaceID),
+		System:         new(string(scope.EntityType)),
+		WorkitemTypeID: workitemTypeID,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if scope.EntityType == EntityTypeStory {
+		workflow.ParallelNodes, _, err = client.WorkflowService.GetWorkflowParallelNodes(ctx, &GetWorkflowParallelNodesRequest{
+			WorkspaceID:    new(scope.WorkspaceID),
+			System:         new(string(scope.EntityType)),
+			WorkitemTypeID: workitemTypeID,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return workflow, nil
+}
+
+// Transition returns the transition between the statuses, which may be given
+// as aliases or Chinese names.
+func (w *Workflow) Transition(from, to string) (*WorkflowTransition, bool) {
+	from, to = w.alias(from), w.alias(to)
+	for _, transition := range w.Transitions {
+		if transition.From == from && transition.To == to {
+			return transition, true
+		}
+	}
+	return nil, false
+}
+
+// NextTransitions returns the transitions leaving the status.
+func (w *Workflow) NextTransitions(from string) []*WorkflowTransition {
+	from = w.alias(from)
+
+	var transitions []*WorkflowTransition
+	for _, transition := range w.Transitions {
+		if transition.From == from {
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions
+}
+
+// ParallelNode returns the parallel node containing the status.
+func (w *Workflow) ParallelNode(status string) (*WorkflowParallelNode, bool) {
+	status = w.alias(status)
+	for _, node := range w.ParallelNodes {
+		for _, alias := range node.Statuses {
+			if alias == status {
+				return node, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (w *Workflow) alias(status string) string {
+	if alias, ok := w.States.Alias(status); ok {
+		return alias
+	}
+	return status
+}
+
+// completeTransitions allows every status to transition to every other one.
+func completeTransitions(states *WorkflowStates) []*WorkflowTransition {
+	statuses := states.Statuses()
+	transitions := make([]*WorkflowTransition, 0, len(statuses)*(len(statuses)-1))
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if from.Alias == to.Alias {
+				continue
+			}
+			transitions = append(transitions, &WorkflowTransition{
+				Name: from.Alias + "-" + to.Alias,
+				From: from.Alias,
+				To:   to.Alias,
+			})
+		}
+	}
+	return transitions
+}
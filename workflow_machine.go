@@ -0,0 +1,285 @@
+package tapd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrWorkflowIllegalTransition is returned when the workflow has no
+	// transition between the statuses.
+	ErrWorkflowIllegalTransition = errors.New("tapd: illegal workflow transition")
+
+	// ErrWorkflowMissingFields is returned when the fields required by a
+	// transition are not provided.
+	ErrWorkflowMissingFields = errors.New("tapd: workflow transition requires missing fields")
+)
+
+// WorkflowTransitionError describes a transition rejected by a WorkflowMachine.
+type WorkflowTransitionError struct {
+	From          string   // 流转前状态
+	To            string   // 流转后状态
+	MissingFields []string // 缺少的必填字段，为空表示不存在该流转
+}
+
+func (e *WorkflowTransitionError) Error() string {
+	if len(e.MissingFields) > 0 {
+		return fmt.Sprintf("tapd: transition from [%s] to [%s] requires fields [%s]",
+			e.From, e.To, strings.Join(e.MissingFields, ","))
+	}
+	return fmt.Sprintf("tapd: illegal workflow transition from [%s] to [%s]", e.From, e.To)
+}
+
+func (e *WorkflowTransitionError) Unwrap() error {
+	if len(e.MissingFields) > 0 {
+		return ErrWorkflowMissingFields
+	}
+	return ErrWorkflowIllegalTransition
+}
+
+// WorkflowMachine validates status changes against a Workflow before they are
+// sent to TAPD, so illegal jumps fail fast with a descriptive error.
+type WorkflowMachine struct {
+	workflow *Workflow
+	autoStep bool
+}
+
+type WorkflowMachineOption func(*WorkflowMachine)
+
+// WithAutoStep makes Update step through the intermediate statuses of the
+// shortest path when there is no direct transition to the target status.
+func WithAutoStep() WorkflowMachineOption {
+	return func(m *WorkflowMachine) {
+		m.autoStep = true
+	}
+}
+
+// NewWorkflowMachine creates a state machine for the workflow.
+func NewWorkflowMachine(workflow *Workflow, opts ...WorkflowMachineOption) *WorkflowMachine {
+	m := &WorkflowMachine{
+		workflow: workflow,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Validate checks that the workflow allows the transition and that values,
+// keyed by field name, provide every field required by it.
+//
+// Statuses may be given as aliases or Chinese names.
+func (m *WorkflowMachine) Validate(from, to string, values map[string]string) (*WorkflowTransition, error) {
+	from, to = m.workflow.alias(from), m.workflow.alias(to)
+
+	transition, ok := m.workflow.Transition(from, to)
+	if !ok {
+		return nil, &WorkflowTransitionError{From: from, To: to}
+	}
+
+	var missing []string
+	for _, field := range transition.RequiredFields() {
+		if strings.TrimSpace(values[field]) == "" {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &WorkflowTransitionError{From: from, To: to, MissingFields: missing}
+	}
+
+	return transition, nil
+}
+
+// ValidateRequest validates the status change of an update request, such as
+// UpdateStoryRequest, UpdateBugRequest or UpdateTaskRequest, for a work item
+// currently in the from status. Requests without status are always valid.
+func (m *WorkflowMachine) ValidateRequest(from string, request any) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		values[key] = stringifyJSONRaw(value)
+	}
+
+	to := values["status"]
+	if to == "" || m.workflow.alias(to) == m.workflow.alias(from) {
+		return nil
+	}
+
+	_, err = m.Validate(from, to, values)
+	return err
+}
+
+// ShortestPath returns the fewest transitions leading from one status to
+// another, or an empty path if both are the same status.
+func (m *WorkflowMachine) ShortestPath(from, to string) ([]*WorkflowTransition, error) {
+	from, to = m.workflow.alias(from), m.workflow.alias(to)
+	if from == to {
+		return nil, nil
+	}
+
+	previous := map[string]*WorkflowTransition{from: nil}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, transition := range m.workflow.NextTransitions(current) {
+			if _, visited := previous[transition.To]; visited {
+				continue
+			}
+			previous[transition.To] = transition
+			if transition.To == to {
+				return buildWorkflowPath(previous, to), nil
+			}
+			queue = append(queue, transition.To)
+		}
+	}
+
+	return nil, &WorkflowTransitionError{From: from, To: to}
+}
+
+// Update validates the status change of the request for a work item
+// currently in the from status, then updates it through WorkItemService.
+//
+// Without a direct transition Update fails, unless WithAutoStep is set: the
+// work item then walks the shortest path, where each intermediate update
+// changes the status along with the fields required by its transition, taken
+// from the request, and the final update applies the whole request. Every
+// transition of the path is validated before the first update is sent.
+func (m *WorkflowMachine) Update(
+	ctx context.Context, client *Client, from string, request *UpdateWorkItemRequest, opts ...RequestOption,
+) (WorkItem, error) {
+	if request.Status == nil || m.workflow.alias(*request.Status) == m.workflow.alias(from) {
+		item, _, err := client.WorkItemService.UpdateWorkItem(ctx, request, opts...)
+		return item, err
+	}
+
+	values := updateWorkItemValues(request)
+	to := *request.Status
+
+	path := []*WorkflowTransition(nil)
+	if _, ok := m.workflow.Transition(from, to); ok || !m.autoStep {
+		transition, err := m.Validate(from, to, values)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, transition)
+	} else {
+		var err error
+		if path, err = m.ShortestPath(from, to); err != nil {
+			return nil, err
+		}
+		for _, transition := range path {
+			if _, err := m.Validate(transition.From, transition.To, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, transition := range path[:len(path)-1] {
+		if _, _, err := client.WorkItemService.UpdateWorkItem(ctx, stepWorkItemRequest(request, transition), opts...); err != nil {
+			return nil, fmt.Errorf("tapd: step to [%s]: %w", transition.To, err)
+		}
+	}
+
+	final := *request
+	final.Status = new(path[len(path)-1].To)
+	item, _, err := client.WorkItemService.UpdateWorkItem(ctx, &final, opts...)
+	return item, err
+}
+
+// stepWorkItemRequest returns the update moving the work item of the request
+// through the intermediate transition, carrying the values of the request for
+// the fields the transition requires.
+func stepWorkItemRequest(request *UpdateWorkItemRequest, transition *WorkflowTransition) *UpdateWorkItemRequest {
+	step := &UpdateWorkItemRequest{
+		EntityType:  request.EntityType,
+		WorkspaceID: request.WorkspaceID,
+		ID:          request.ID,
+		CurrentUser: request.CurrentUser,
+		Status:      new(transition.To),
+	}
+
+	nameField, ownerField := "name", "owner"
+	if deref(request.EntityType) == EntityTypeBug {
+		nameField, ownerField = "title", "current_owner"
+	}
+
+	for _, field := range transition.RequiredFields() {
+		switch field {
+		case nameField:
+			step.Name = request.Name
+		case ownerField:
+			step.Owner = request.Owner
+		case "begin":
+			step.Begin = request.Begin
+		case "due":
+			step.Due = request.Due
+		case "effort":
+			step.Effort = request.Effort
+		case "iteration_id":
+			step.IterationID = request.IterationID
+		default:
+			if value, ok := request.CustomFields[field]; ok {
+				if step.CustomFields == nil {
+					step.CustomFields = make(CustomFields)
+				}
+				step.CustomFields[field] = value
+			}
+		}
+	}
+
+	return step
+}
+
+func buildWorkflowPath(previous map[string]*WorkflowTransition, to string) []*WorkflowTransition {
+	var path []*WorkflowTransition
+	for transition := previous[to]; transition != nil; transition = previous[transition.From] {
+		path = append([]*WorkflowTransition{transition}, path...)
+	}
+	return path
+}
+
+// updateWorkItemValues returns the values of the request keyed by the field
+// names of its entity type.
+func updateWorkItemValues(request *UpdateWorkItemRequest) map[string]string {
+	values := make(map[string]string, len(request.CustomFields)+8)
+	for field, value := range request.CustomFields {
+		values[field] = value
+	}
+
+	nameField, ownerField := "name", "owner"
+	if deref(request.EntityType) == EntityTypeBug {
+		nameField, ownerField = "title", "current_owner"
+	}
+
+	set := func(field string, value *string) {
+		if value != nil {
+			values[field] = *value
+		}
+	}
+	set(nameField, request.Name)
+	set(ownerField, request.Owner)
+	set("status", request.Status)
+	set("begin", request.Begin)
+	set("due", request.Due)
+	set("effort", request.Effort)
+	if request.IterationID != nil {
+		values["iteration_id"] = strconv.FormatInt(*request.IterationID, 10)
+	}
+
+	return values
+}
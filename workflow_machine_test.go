@@ -0,0 +1,124 @@
+package tapd
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWorkflowMachine(t *testing.T, opts ...WorkflowMachineOption) *WorkflowMachine {
+	t.Helper()
+
+	workflow, err := LoadWorkflow(ctx, newWorkflowTestServerClient(t), WorkflowScope{
+		WorkspaceID:    11112222,
+		EntityType:     EntityTypeStory,
+		WorkitemTypeID: 1112222991001000013,
+	})
+	require.NoError(t, err)
+	return NewWorkflowMachine(workflow, opts...)
+}
+
+func TestWorkflowMachine_Validate(t *testing.T) {
+	machine := newTestWorkflowMachine(t)
+
+	transition, err := machine.Validate("规划中", "developing", map[string]string{"owner": "张三"})
+	require.NoError(t, err)
+	assert.Equal(t, "planning-developing", transition.Name)
+
+	_, err = machine.Validate("planning", "developing", map[string]string{"due": "2025-01-01"})
+	assert.ErrorIs(t, err, ErrWorkflowMissingFields)
+	var transitionErr *WorkflowTransitionError
+	require.ErrorAs(t, err, &transitionErr)
+	assert.Equal(t, []string{"owner"}, transitionErr.MissingFields)
+
+	_, err = machine.Validate("planning", "status_2", nil)
+	assert.ErrorIs(t, err, ErrWorkflowIllegalTransition)
+	assert.EqualError(t, err, "tapd: illegal workflow transition from [planning] to [status_2]")
+}
+
+func TestWorkflowMachine_ValidateRequest(t *testing.T) {
+	machine := newTestWorkflowMachine(t)
+
+	assert.NoError(t, machine.ValidateRequest("planning", &UpdateStoryRequest{Name: new("name")}))
+	assert.NoError(t, machine.ValidateRequest("developing", &UpdateStoryRequest{
+		Status:       new("status_3"),
+		CustomFields: CustomFields{"custom_field_one": "李四"},
+	}))
+	assert.ErrorIs(t, machine.ValidateRequest("developing", &UpdateStoryRequest{
+		Status: new("status_3"),
+	}), ErrWorkflowMissingFields)
+	assert.ErrorIs(t, machine.ValidateRequest("planning", &UpdateStoryRequest{
+		Status: new("已验收"),
+	}), ErrWorkflowIllegalTransition)
+}
+
+func TestWorkflowMachine_ShortestPath(t *testing.T) {
+	machine := newTestWorkflowMachine(t)
+
+	path, err := machine.ShortestPath("planning", "status_2")
+	require.NoError(t, err)
+	require.Len(t, path, 3)
+	assert.Equal(t, "developing", path[0].To)
+	assert.Equal(t, "status_3", path[1].To)
+	assert.Equal(t, "status_2", path[2].To)
+
+	path, err = machine.ShortestPath("planning", "规划中")
+	require.NoError(t, err)
+	assert.Empty(t, path)
+
+	_, err = machine.ShortestPath("rejected", "planning")
+	assert.ErrorIs(t, err, ErrWorkflowIllegalTransition)
+}
+
+func TestWorkflowMachine_Update(t *testing.T) {
+	var (
+		statuses []string
+		bodies   []map[string]any
+	)
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/stories", r.URL.Path)
+
+		var req map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		statuses = append(statuses, req["status"].(string))
+		bodies = append(bodies, req)
+
+		_, _ = w.Write([]byte(`{"status":1,"data":{"Story":{"id":"1","status":"` + req["status"].(string) + `"}},"info":"success"}`))
+	}))
+
+	request := &UpdateWorkItemRequest{
+		EntityType:   new(EntityTypeStory),
+		WorkspaceID:  new(11112222),
+		ID:           new(int64(1)),
+		Owner:        new("张三"),
+		Status:       new("status_2"),
+		CustomFields: CustomFields{"custom_field_one": "李四"},
+	}
+
+	_, err := newTestWorkflowMachine(t).Update(ctx, client, "planning", request)
+	assert.ErrorIs(t, err, ErrWorkflowIllegalTransition)
+	assert.Empty(t, statuses)
+
+	item, err := newTestWorkflowMachine(t, WithAutoStep()).Update(ctx, client, "规划中", request)
+	require.NoError(t, err)
+	assert.Equal(t, "status_2", item.GetStatus())
+	assert.Equal(t, []string{"developing", "status_3", "status_2"}, statuses)
+	assert.Equal(t, "张三", bodies[0]["owner"])
+	assert.NotContains(t, bodies[0], "custom_field_one")
+	assert.Equal(t, "李四", bodies[1]["custom_field_one"])
+	assert.NotContains(t, bodies[1], "owner")
+
+	statuses = nil
+	_, err = newTestWorkflowMachine(t, WithAutoStep()).Update(ctx, client, "planning", &UpdateWorkItemRequest{
+		EntityType: new(EntityTypeStory),
+		ID:         new(int64(1)),
+		Owner:      new("张三"),
+		Status:     new("status_2"),
+	})
+	assert.ErrorIs(t, err, ErrWorkflowMissingFields)
+	assert.Empty(t, statuses)
+}
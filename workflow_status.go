@@ -0,0 +1,271 @@
+package tapd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// WorkflowScope identifies the workflow of a work item type in a workspace.
+type WorkflowScope struct {
+	WorkspaceID    int        // 项目ID
+	EntityType     EntityType // 业务对象类型，story、bug、task
+	WorkitemTypeID int64      // 需求类别ID，仅需求有效，为 0 时合并所有类别
+}
+
+// WorkflowStates holds the statuses of a workflow together with its initial
+// and terminal states.
+type WorkflowStates struct {
+	statuses []*WorkflowStatus
+	names    map[string]string // alias => name
+	aliases  map[string]string // name => alias
+	initial  map[string]bool   // alias
+	terminal map[string]bool   // alias
+}
+
+// NewWorkflowStates creates workflow states from the statuses and the aliases
+// of the initial and terminal states.
+func NewWorkflowStates(statuses []*WorkflowStatus, initial, terminal []string) *WorkflowStates {
+	w := &WorkflowStates{
+		statuses: statuses,
+		names:    make(map[string]string, len(statuses)),
+		aliases:  make(map[string]string, len(statuses)),
+		initial:  make(map[string]bool, len(initial)),
+		terminal: make(map[string]bool, len(terminal)),
+	}
+	for _, status := range statuses {
+		w.names[status.Alias] = status.Name
+		if _, ok := w.aliases[status.Name]; !ok {
+			w.aliases[status.Name] = status.Alias
+		}
+	}
+	for _, alias := range initial {
+		w.initial[alias] = true
+	}
+	for _, alias := range terminal {
+		w.terminal[alias] = true
+	}
+	return w
+}
+
+// Statuses returns the statuses in workflow order.
+func (w *WorkflowStates) Statuses() []*WorkflowStatus {
+	return w.statuses
+}
+
+// Name returns the Chinese name of the status alias.
+func (w *WorkflowStates) Name(alias string) (string, bool) {
+	name, ok := w.names[alias]
+	return name, ok
+}
+
+// Alias returns the alias of the status, which may be given either as its
+// Chinese name or as an alias.
+func (w *WorkflowStates) Alias(status string) (string, bool) {
+	if _, ok := w.names[status]; ok {
+		return status, true
+	}
+	alias, ok := w.aliases[status]
+	return alias, ok
+}
+
+// IsInitial reports whether the status, given as alias or name, is an initial state.
+func (w *WorkflowStates) IsInitial(status string) bool {
+	alias, ok := w.Alias(status)
+	return ok && w.initial[alias]
+}
+
+// IsTerminal reports whether the status, given as alias or name, is a terminal state.
+func (w *WorkflowStates) IsTerminal(status string) bool {
+	alias, ok := w.Alias(status)
+	return ok && w.terminal[alias]
+}
+
+// taskWorkflowStates are the fixed task statuses, tasks have no configurable workflow.
+var taskWorkflowStates = NewWorkflowStates([]*WorkflowStatus{
+	{Alias: string(TaskStatusOpen), Name: "未开始"},
+	{Alias: string(TaskStatusProgressing), Name: "进行中"},
+	{Alias: string(TaskStatusDone), Name: "已完成"},
+}, []string{string(TaskStatusOpen)}, []string{string(TaskStatusDone)})
+
+// WorkflowStatusResolver loads and caches the workflow states per workspace
+// and work item type, and translates statuses between aliases (such as
+// status_3) and their Chinese names.
+//
+// It is safe for concurrent use.
+type WorkflowStatusResolver struct {
+	client *Client
+
+	mu    sync.Mutex
+	cache map[WorkflowScope]*WorkflowStates
+}
+
+// NewWorkflowStatusResolver creates a resolver using the client.
+func NewWorkflowStatusResolver(client *Client) *WorkflowStatusResolver {
+	return &WorkflowStatusResolver{
+		client: client,
+		cache:  make(map[WorkflowScope]*WorkflowStates),
+	}
+}
+
+// States returns the workflow states of the scope, loading them on first use.
+func (r *WorkflowStatusResolver) States(
+	ctx context.Context, scope WorkflowScope, opts ...RequestOption,
+) (*WorkflowStates, error) {
+	r.mu.Lock()
+	states, ok := r.cache[scope]
+	r.mu.Unlock()
+	if ok {
+		return states, nil
+	}
+
+	states, err := loadWorkflowStates(ctx, r.client, scope, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	r.cache[scope] = states
+	r.mu.Unlock()
+
+	return states, nil
+}
+
+// Invalidate drops the cached states of the scope.
+func (r *WorkflowStatusResolver) Invalidate(scope WorkflowScope) {
+	r.mu.Lock()
+	delete(r.cache, scope)
+	r.mu.Unlock()
+}
+
+// Name returns the Chinese name of the status alias.
+func (r *WorkflowStatusResolver) Name(
+	ctx context.Context, scope WorkflowScope, alias string, opts ...RequestOption,
+) (string, error) {
+	states, err := r.States(ctx, scope, opts...)
+	if err != nil {
+		return "", err
+	}
+	name, ok := states.Name(alias)
+	if !ok {
+		return "", fmt.Errorf("tapd: unknown workflow status [%s]", alias)
+	}
+	return name, nil
+}
+
+// Alias returns the alias of the status given as Chinese name or alias.
+func (r *WorkflowStatusResolver) Alias(
+	ctx context.Context, scope WorkflowScope, status string, opts ...RequestOption,
+) (string, error) {
+	states, err := r.States(ctx, scope, opts...)
+	if err != nil {
+		return "", err
+	}
+	alias, ok := states.Alias(status)
+	if !ok {
+		return "", fmt.Errorf("tapd: unknown workflow status [%s]", status)
+	}
+	return alias, nil
+}
+
+// IsInitial reports whether the status is an initial state of the workflow.
+func (r *WorkflowStatusResolver) IsInitial(
+	ctx context.Context, scope WorkflowScope, status string, opts ...RequestOption,
+) (bool, error) {
+	states, err := r.States(ctx, scope, opts...)
+	if err != nil {
+		return false, err
+	}
+	return states.IsInitial(status), nil
+}
+
+// IsTerminal reports whether the status is a terminal state of the workflow.
+func (r *WorkflowStatusResolver) IsTerminal(
+	ctx context.Context, scope WorkflowScope, status string, opts ...RequestOption,
+) (bool, error) {
+	states, err := r.States(ctx, scope, opts...)
+	if err != nil {
+		return false, err
+	}
+	return states.IsTerminal(status), nil
+}
+
+func loadWorkflowStates(
+	ctx context.Context, client *Client, scope WorkflowScope, opts ...RequestOption,
+) (*WorkflowStates, error) {
+	switch scope.EntityType {
+	case EntityTypeTask:
+		return taskWorkflowStates, nil
+	case EntityTypeStory, EntityTypeBug:
+		// loaded below
+	default:
+		return nil, fmt.Errorf("tapd: workflow of entity type [%s] not supported", scope.EntityType)
+	}
+
+	var workitemTypeID *int64
+	if scope.WorkitemTypeID != 0 {
+		workitemTypeID = new(scope.WorkitemTypeID)
+	}
+
+	system := new(string(scope.EntityType))
+	statuses, _, err := client.WorkflowService.GetWorkflowStatusMap(ctx, &GetWorkflowStatusMapRequest{
+		WorkspaceID:    new(scope.WorkspaceID),
+		System:         system,
+		WorkitemTypeID: workitemTypeID,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	firstSteps, _, err := client.WorkflowService.GetWorkflowFirstSteps(ctx, &GetWorkflowFirstStepsRequest{
+		WorkspaceID:    new(scope.WorkspaceID),
+		System:         system,
+		WorkitemTypeID: workitemTypeID,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var terminal []string
+	if scope.EntityType == EntityTypeStory {
+		// all last steps are grouped by workitem type, so one request covers every story category
+		steps, _, err := client.WorkflowService.GetAllLastSteps(ctx, &GetAllLastStepsRequest{
+			WorkspaceID: new(scope.WorkspaceID),
+			System:      system,
+			GroupKey:    new("workitem_type_id"),
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, step := range steps {
+			if workitemTypeID != nil && step.Key != strconv.FormatInt(*workitemTypeID, 10) {
+				continue
+			}
+			for _, status := range step.Status {
+				if !slices.Contains(terminal, status.Alias) {
+					terminal = append(terminal, status.Alias)
+				}
+			}
+		}
+	} else {
+		lastSteps, _, err := client.WorkflowService.GetWorkflowLastSteps(ctx, &GetWorkflowLastStepsRequest{
+			WorkspaceID: new(scope.WorkspaceID),
+			System:      system,
+		}, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, status := range lastSteps {
+			terminal = append(terminal, status.Alias)
+		}
+	}
+
+	initial := make([]string, 0, len(firstSteps))
+	for _, status := range firstSteps {
+		initial = append(initial, status.Alias)
+	}
+
+	return NewWorkflowStates(statuses, initial, terminal), nil
+}
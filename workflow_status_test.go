@@ -0,0 +1,84 @@
+package tapd
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStatusResolver_Story(t *testing.T) {
+	var requests atomic.Int32
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+		assert.Equal(t, "story", r.URL.Query().Get("system"))
+
+		switch r.URL.Path {
+		case "/workflows/status_map":
+			assert.Equal(t, "1112222991001000013", r.URL.Query().Get("workitem_type_id"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_status_map.json"))
+		case "/workflows/first_steps":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_first_steps.json"))
+		case "/workflows/all_last_steps":
+			assert.Equal(t, "workitem_type_id", r.URL.Query().Get("group_key"))
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_all_last_steps.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+
+	resolver := NewWorkflowStatusResolver(client)
+	scope := WorkflowScope{WorkspaceID: 11112222, EntityType: EntityTypeStory, WorkitemTypeID: 1112222991001000013}
+
+	name, err := resolver.Name(ctx, scope, "status_3")
+	require.NoError(t, err)
+	assert.Equal(t, "已验收", name)
+
+	alias, err := resolver.Alias(ctx, scope, "实现中")
+	require.NoError(t, err)
+	assert.Equal(t, "developing", alias)
+
+	_, err = resolver.Alias(ctx, scope, "不存在")
+	assert.Error(t, err)
+
+	initial, err := resolver.IsInitial(ctx, scope, "规划中")
+	require.NoError(t, err)
+	assert.True(t, initial)
+
+	terminal, err := resolver.IsTerminal(ctx, scope, "status_2")
+	require.NoError(t, err)
+	assert.True(t, terminal)
+
+	terminal, err = resolver.IsTerminal(ctx, scope, "developing")
+	require.NoError(t, err)
+	assert.False(t, terminal)
+
+	// cached after the first load
+	assert.Equal(t, int32(3), requests.Load())
+
+	resolver.Invalidate(scope)
+	_, err = resolver.States(ctx, scope)
+	require.NoError(t, err)
+	assert.Equal(t, int32(6), requests.Load())
+}
+
+func TestWorkflowStatusResolver_Task(t *testing.T) {
+	resolver := NewWorkflowStatusResolver(nil)
+	scope := WorkflowScope{WorkspaceID: 11112222, EntityType: EntityTypeTask}
+
+	states, err := resolver.States(ctx, scope)
+	require.NoError(t, err)
+	assert.Len(t, states.Statuses(), 3)
+	assert.True(t, states.IsInitial(string(TaskStatusOpen)))
+	assert.True(t, states.IsTerminal("已完成"))
+
+	name, ok := states.Name(string(TaskStatusProgressing))
+	assert.True(t, ok)
+	assert.Equal(t, "进行中", name)
+
+	_, err = resolver.States(ctx, WorkflowScope{EntityType: EntityType("iteration")})
+	assert.Error(t, err)
+}
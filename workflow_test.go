@@ -0,0 +1,72 @@
+package tapd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWorkflowTestServerClient(t *testing.T) *Client {
+	t.Helper()
+
+	_, client := createServerClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "11112222", r.URL.Query().Get("workspace_id"))
+
+		switch r.URL.Path {
+		case "/workflows/status_map":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_status_map.json"))
+		case "/workflows/first_steps":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_first_steps.json"))
+		case "/workflows/all_last_steps":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_all_last_steps.json"))
+		case "/workflows/transitions":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_transitions.json"))
+		case "/workflows/parallel_nodes":
+			_, _ = w.Write(loadData(t, "internal/testdata/api/workflow/get_workflow_parallel_nodes.json"))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	return client
+}
+
+func TestWorkflow_LoadWorkflow(t *testing.T) {
+	client := newWorkflowTestServerClient(t)
+
+	workflow, err := LoadWorkflow(ctx, client, WorkflowScope{
+		WorkspaceID:    11112222,
+		EntityType:     EntityTypeStory,
+		WorkitemTypeID: 1112222991001000013,
+	})
+	require.NoError(t, err)
+	assert.Len(t, workflow.States.Statuses(), 5)
+	assert.True(t, workflow.States.IsInitial("planning"))
+	assert.True(t, workflow.States.IsTerminal("已上线"))
+	assert.Len(t, workflow.Transitions, 4)
+
+	transition, ok := workflow.Transition("规划中", "developing")
+	require.True(t, ok)
+	assert.Equal(t, []string{"owner"}, transition.RequiredFields())
+
+	_, ok = workflow.Transition("planning", "status_2")
+	assert.False(t, ok)
+
+	assert.Len(t, workflow.NextTransitions("planning"), 2)
+	assert.Empty(t, workflow.NextTransitions("status_2"))
+
+	node, ok := workflow.ParallelNode("已验收")
+	require.True(t, ok)
+	assert.Equal(t, "node_1", node.ID)
+}
+
+func TestWorkflow_LoadWorkflow_Task(t *testing.T) {
+	workflow, err := LoadWorkflow(ctx, nil, WorkflowScope{WorkspaceID: 11112222, EntityType: EntityTypeTask})
+	require.NoError(t, err)
+	assert.Len(t, workflow.Transitions, 6)
+
+	_, ok := workflow.Transition("未开始", "已完成")
+	assert.True(t, ok)
+	assert.Empty(t, workflow.ParallelNodes)
+}